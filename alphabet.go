@@ -0,0 +1,107 @@
+package ulid
+
+import "errors"
+
+// ErrAlphabet is returned by NewEncoding when the alphabet is not made of
+// 32 printable ASCII characters in strictly ascending order.
+var ErrAlphabet = errors.New("ulid: invalid alphabet")
+
+// Encoding is a base32 encoding of ULIDs with a custom alphabet, for IDs
+// that must avoid some characters or match a legacy scheme. Encoded ULIDs
+// are EncodedSize characters long and, the alphabet being in ascending
+// order, sort like the ULIDs.
+//
+// An Encoding is safe for concurrent use.
+type Encoding struct {
+	alphabet string
+
+	// Correspondance avec l'alphabet de Crockford, 0xFF pour les caractères
+	// invalides en décodage
+	enc [256]byte
+	dec [256]byte
+}
+
+// Crockford is the Encoding of String and ParseStrict.
+var Crockford = mustEncoding(string(enc[:]))
+
+func mustEncoding(alphabet string) *Encoding {
+	e, err := NewEncoding(alphabet)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// NewEncoding returns an Encoding using alphabet, whose characters stand
+// for the digits 0 to 31. ErrAlphabet is returned unless alphabet is made
+// of 32 printable ASCII characters, space excluded, in strictly ascending
+// order.
+func NewEncoding(alphabet string) (*Encoding, error) {
+	if len(alphabet) != len(enc) {
+		return nil, ErrAlphabet
+	}
+	e := &Encoding{alphabet: alphabet}
+	for i := range e.dec {
+		e.dec[i] = 0xFF
+	}
+	for i := 0; i < len(alphabet); i++ {
+		c := alphabet[i]
+		if c <= ' ' || c > '~' || i > 0 && c <= alphabet[i-1] {
+			return nil, ErrAlphabet
+		}
+		e.enc[enc[i]] = c
+		e.dec[c] = enc[i]
+	}
+	return e, nil
+}
+
+// Alphabet returns the alphabet of the encoding.
+func (e *Encoding) Alphabet() string {
+	return e.alphabet
+}
+
+// Encode returns the encoding of id.
+func (e *Encoding) Encode(id ULID) string {
+	var buf [EncodedSize]byte
+	return string(e.AppendEncode(buf[:0], id))
+}
+
+// AppendEncode appends the encoding of id to dst.
+func (e *Encoding) AppendEncode(dst []byte, id ULID) []byte {
+	n := len(dst)
+	dst = append(dst, make([]byte, EncodedSize)...)
+	b := dst[n:]
+	_ = id.MarshalTextTo(b)
+	for i, c := range b {
+		b[i] = e.enc[c]
+	}
+	return dst
+}
+
+// Decode decodes src, which must only hold characters of the alphabet.
+// ErrDataSize is returned if len(src) != EncodedSize, ErrInvalidCharacters
+// if src holds other characters and ErrOverflow if the first digit is
+// larger than 7.
+func (e *Encoding) Decode(src []byte) (ULID, error) {
+	return decodeAlphabet(e, src)
+}
+
+// Parse is like Decode for a string.
+func (e *Encoding) Parse(s string) (ULID, error) {
+	return decodeAlphabet(e, s)
+}
+
+func decodeAlphabet[T string | []byte](e *Encoding, v T) (id ULID, err error) {
+	if len(v) != EncodedSize {
+		return id, ErrDataSize
+	}
+	var buf [EncodedSize]byte
+	for i := range buf {
+		c := e.dec[v[i]]
+		if c == 0xFF {
+			return id, ErrInvalidCharacters
+		}
+		buf[i] = c
+	}
+	return id, decode(&id, buf[:], true)
+}
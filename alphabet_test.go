@@ -0,0 +1,80 @@
+package ulid
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNewEncoding(t *testing.T) {
+	for _, alphabet := range []string{
+		"",
+		"0123456789ABCDEFGHJKMNPQRSTVWXY",     // 31 characters
+		"0123456789ABCDEFGHJKMNPQRSTVWXZY",    // not sorted
+		"0123456789ABCDEFGHJKMNPQRSTVWXYY",    // duplicate
+		" 123456789ABCDEFGHJKMNPQRSTVWXYZ",    // space
+		"0123456789ABCDEFGHJKMNPQRSTVWXY\x7f", // not printable
+	} {
+		if _, err := NewEncoding(alphabet); err != ErrAlphabet {
+			t.Errorf("NewEncoding(%q) error = %v, want %v", alphabet, err, ErrAlphabet)
+		}
+	}
+}
+
+func TestEncoding(t *testing.T) {
+	// Crockford without digits that look like letters
+	e, err := NewEncoding("23456789ABCDEFGHJKMNPQRSTVWXYZab")
+	if err != nil {
+		t.Fatalf("NewEncoding() error = %v", err)
+	}
+
+	id := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	s := e.Encode(id)
+	if want := "23CQ6b29Da9BNC3529VTBZ6PX5"; s != want {
+		t.Errorf("Encode() = %s, want %s", s, want)
+	}
+	if got, err := e.Parse(s); err != nil || got != id {
+		t.Errorf("Parse(%s) = %v, %v, want %v", s, got, err, id)
+	}
+	if got, err := e.Decode([]byte(s)); err != nil || got != id {
+		t.Errorf("Decode(%s) = %v, %v, want %v", s, got, err, id)
+	}
+	if got := string(e.AppendEncode([]byte("id:"), id)); got != "id:"+s {
+		t.Errorf("AppendEncode() = %s, want id:%s", got, s)
+	}
+
+	tests := []struct {
+		s   string
+		err error
+	}{
+		{"23CQ6b29Da9BNC3529VTBZ6PX", ErrDataSize},
+		{"03CQ6b29Da9BNC3529VTBZ6PX5", ErrInvalidCharacters},
+		{"23cq6b29Da9BNC3529VTBZ6PX5", ErrInvalidCharacters},
+		{"A3CQ6b29Da9BNC3529VTBZ6PX5", ErrOverflow},
+	}
+	for _, tt := range tests {
+		if _, err := e.Parse(tt.s); err != tt.err {
+			t.Errorf("Parse(%s) error = %v, want %v", tt.s, err, tt.err)
+		}
+	}
+
+	// Encoded IDs sort like the ULIDs
+	ids := []ULID{Make(), Make(), Make(), {}, MustParse("7ZZZZZZZZZZZZZZZZZZZZZZZZZ")}
+	Sort(ids)
+	var encoded []string
+	for _, id := range ids {
+		encoded = append(encoded, e.Encode(id))
+	}
+	if !slices.IsSorted(encoded) {
+		t.Errorf("Encode() does not sort like the ULIDs: %v", encoded)
+	}
+}
+
+func TestCrockford(t *testing.T) {
+	id := Make()
+	if got := Crockford.Encode(id); got != id.String() {
+		t.Errorf("Crockford.Encode() = %s, want %s", got, id)
+	}
+	if Crockford.Alphabet() != string(enc[:]) {
+		t.Errorf("Crockford.Alphabet() = %s", Crockford.Alphabet())
+	}
+}
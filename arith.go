@@ -0,0 +1,44 @@
+package ulid
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Increment returns the ULID immediately following id when treated as a
+// big-endian 128-bit integer. The boolean is false if the increment
+// overflowed, in which case the returned ULID wrapped around to zero.
+func (id ULID) Increment() (ULID, bool) {
+	return id.Add(1)
+}
+
+// Decrement returns the ULID immediately preceding id when treated as a
+// big-endian 128-bit integer. The boolean is false if the decrement
+// underflowed, in which case the returned ULID wrapped around to all ones.
+func (id ULID) Decrement() (ULID, bool) {
+	hi := binary.BigEndian.Uint64(id[:8])
+	lo := binary.BigEndian.Uint64(id[8:])
+
+	lo, borrow := bits.Sub64(lo, 1, 0)
+	hi, borrow = bits.Sub64(hi, 0, borrow)
+
+	var res ULID
+	binary.BigEndian.PutUint64(res[:8], hi)
+	binary.BigEndian.PutUint64(res[8:], lo)
+	return res, borrow == 0
+}
+
+// Add returns id + n when treated as a big-endian 128-bit integer.
+// The boolean is false if the addition overflowed.
+func (id ULID) Add(n uint64) (ULID, bool) {
+	hi := binary.BigEndian.Uint64(id[:8])
+	lo := binary.BigEndian.Uint64(id[8:])
+
+	lo, carry := bits.Add64(lo, n, 0)
+	hi, carry = bits.Add64(hi, 0, carry)
+
+	var res ULID
+	binary.BigEndian.PutUint64(res[:8], hi)
+	binary.BigEndian.PutUint64(res[8:], lo)
+	return res, carry == 0
+}
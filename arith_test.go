@@ -0,0 +1,57 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestIncrementDecrement(t *testing.T) {
+	id := MustNew(1, nil)
+
+	next, ok := id.Increment()
+	if !ok || !id.Less(next) {
+		t.Errorf("Increment() = %v, %v", next, ok)
+	}
+
+	prev, ok := next.Decrement()
+	if !ok || prev != id {
+		t.Errorf("Decrement(Increment()) = %v, %v, want %v", prev, ok, id)
+	}
+
+	// Carry across the 64-bit boundary
+	var low ULID
+	for i := 8; i < RawSize; i++ {
+		low[i] = 0xFF
+	}
+	got, ok := low.Increment()
+	want := ULID{7: 1}
+	if !ok || got != want {
+		t.Errorf("Increment() carry = %v, %v, want %v", got, ok, want)
+	}
+
+	var max ULID
+	for i := range max {
+		max[i] = 0xFF
+	}
+	if got, ok := max.Increment(); ok || got != Nil {
+		t.Errorf("Increment() overflow = %v, %v, want %v, false", got, ok, Nil)
+	}
+	if got, ok := Nil.Decrement(); ok || got != max {
+		t.Errorf("Decrement() underflow = %v, %v, want %v, false", got, ok, max)
+	}
+}
+
+func TestAdd(t *testing.T) {
+	id := MustNew(1, nil)
+	got, ok := id.Add(10)
+	if !ok {
+		t.Fatal("Add() overflowed")
+	}
+
+	want := id
+	for i := 0; i < 10; i++ {
+		want, _ = want.Increment()
+	}
+	if got != want {
+		t.Errorf("Add(10) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,107 @@
+package ulid
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"errors"
+)
+
+// ErrArray is returned when scanning a value that is not a one-dimensional
+// Postgres array literal.
+var ErrArray = errors.New("ulid: invalid array literal")
+
+// ULIDs is a slice of ULIDs stored as a Postgres array. It scans text[]
+// and uuid[] columns, the elements being in the ULID or the UUID text form,
+// and is written as a text[] literal; use UUIDArray for uuid[] columns.
+// NULL scans to a nil slice and a nil slice is written as NULL.
+type ULIDs []ULID
+
+// Scan implements the sql.Scanner interface. Invalid elements are reported
+// as a *ListError; NULL elements are not supported.
+func (ids *ULIDs) Scan(src interface{}) error {
+	var b []byte
+	switch x := src.(type) {
+	case nil:
+		*ids = nil
+		return nil
+	case string:
+		b = []byte(x)
+	case []byte:
+		b = x
+	default:
+		return ErrScanValue
+	}
+
+	b = bytes.TrimSpace(b)
+	if len(b) < 2 || b[0] != '{' || b[len(b)-1] != '}' {
+		return ErrArray
+	}
+	b = b[1 : len(b)-1]
+
+	res := make(ULIDs, 0, len(b)/(EncodedSize+1)+1)
+	for i := 0; len(b) > 0; i++ {
+		elem, rest, _ := bytes.Cut(b, []byte{','})
+		b = rest
+		elem = bytes.TrimSpace(elem)
+		if len(elem) >= 2 && elem[0] == '"' && elem[len(elem)-1] == '"' {
+			elem = elem[1 : len(elem)-1]
+		}
+		if len(elem) > 0 && (elem[0] == '{' || elem[0] == '"') {
+			return ErrArray
+		}
+
+		id, err := parseArrayElem(elem)
+		if err != nil {
+			return &ListError{Index: i, Line: 1, Err: err}
+		}
+		res = append(res, id)
+	}
+	*ids = res
+	return nil
+}
+
+func parseArrayElem(b []byte) (ULID, error) {
+	if len(b) == uuidSize {
+		if id, ok := parseUUID(b); ok {
+			return id, nil
+		}
+		return ULID{}, ErrInvalidCharacters
+	}
+	if string(b) == "NULL" {
+		return ULID{}, ErrScanValue
+	}
+	var id ULID
+	return id, decode(&id, b, true)
+}
+
+// Value implements the sql/driver.Valuer interface, returning the ULIDs as
+// a text[] literal.
+func (ids ULIDs) Value() (driver.Value, error) {
+	if ids == nil {
+		return nil, nil
+	}
+	b := make([]byte, 0, 2+len(ids)*(EncodedSize+1))
+	return string(append(AppendAllText(append(b, '{'), ids, ','), '}')), nil
+}
+
+// UUIDArray returns a driver.Valuer writing the ULIDs as a uuid[] literal.
+func (ids ULIDs) UUIDArray() driver.Valuer {
+	return uuidArray(ids)
+}
+
+type uuidArray []ULID
+
+func (ids uuidArray) Value() (driver.Value, error) {
+	if ids == nil {
+		return nil, nil
+	}
+	b := make([]byte, 0, 2+len(ids)*37)
+	b = append(b, '{')
+	for i, id := range ids {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = appendUUID(b, id)
+	}
+	return string(append(b, '}')), nil
+}
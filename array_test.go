@@ -0,0 +1,81 @@
+package ulid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestULIDsScan(t *testing.T) {
+	a := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	b := MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	tests := []struct {
+		input interface{}
+		want  ULIDs
+		err   error
+	}{
+		{input: "{01AN4Z07BY79KA1307SR9X4MV3,01ARZ3NDEKTSV4RRFFQ69G5FAV}", want: ULIDs{a, b}},
+		{input: []byte(`{"01AN4Z07BY79KA1307SR9X4MV3", 01arz3ndektsv4rrffq69g5fav}`), want: ULIDs{a, b}},
+		{input: "{" + uuidString(a) + "," + uuidString(b) + "}", want: ULIDs{a, b}},
+		{input: "{}", want: ULIDs{}},
+		{input: nil, want: nil},
+		{input: "01AN4Z07BY79KA1307SR9X4MV3", err: ErrArray},
+		{input: "{{01AN4Z07BY79KA1307SR9X4MV3}}", err: ErrArray},
+		{input: "{01AN4Z07BY79KA1307SR9X4MV3,NULL}", err: ErrScanValue},
+		{input: "{01AN4Z07BY79KA1307SR9X4MV3,01AN4Z07BY79KA1307SR9X4M!3}", err: ErrInvalidCharacters},
+		{input: 42, err: ErrScanValue},
+	}
+	for _, tt := range tests {
+		got := ULIDs{a}
+		err := got.Scan(tt.input)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("Scan(%v) error = %v, want %v", tt.input, err, tt.err)
+			continue
+		}
+		if tt.err != nil {
+			continue
+		}
+		if (got == nil) != (tt.want == nil) || len(got) != len(tt.want) {
+			t.Errorf("Scan(%v) = %#v, want %#v", tt.input, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("Scan(%v)[%d] = %v, want %v", tt.input, i, got[i], tt.want[i])
+			}
+		}
+	}
+
+	var le *ListError
+	var got ULIDs
+	if err := got.Scan("{01AN4Z07BY79KA1307SR9X4MV3,bad}"); !errors.As(err, &le) || le.Index != 1 {
+		t.Errorf("Scan() error = %v, want *ListError at index 1", err)
+	}
+}
+
+func TestULIDsValue(t *testing.T) {
+	a := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	b := MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	ids := ULIDs{a, b}
+
+	v, err := ids.Value()
+	if want := "{01AN4Z07BY79KA1307SR9X4MV3,01ARZ3NDEKTSV4RRFFQ69G5FAV}"; err != nil || v != want {
+		t.Errorf("Value() = %v, %v, want %s", v, err, want)
+	}
+	v, err = ids.UUIDArray().Value()
+	if want := "{" + uuidString(a) + "," + uuidString(b) + "}"; err != nil || v != want {
+		t.Errorf("UUIDArray().Value() = %v, %v, want %s", v, err, want)
+	}
+
+	var got ULIDs
+	if err := got.Scan(v); err != nil || len(got) != 2 || got[0] != a || got[1] != b {
+		t.Errorf("Scan(UUIDArray()) = %v, %v, want %v", got, err, ids)
+	}
+
+	if v, err := ULIDs(nil).Value(); v != nil || err != nil {
+		t.Errorf("Value(nil) = %v, %v, want NULL", v, err)
+	}
+	if v, _ := (ULIDs{}).Value(); v != "{}" {
+		t.Errorf("Value(empty) = %v, want {}", v)
+	}
+}
@@ -0,0 +1,96 @@
+package ulid
+
+import (
+	"encoding/binary"
+	"runtime"
+	"sync/atomic"
+)
+
+// AtomicULID holds a ULID that can be loaded and updated atomically, e.g.
+// to track the latest ID seen by concurrent consumers:
+//
+//	for {
+//		old := latest.Load()
+//		if !old.Less(id) || latest.CompareAndSwap(old, id) {
+//			break
+//		}
+//	}
+//
+// The ULID is kept in two uint64 atomics guarded by a sequence counter, as
+// Go has no 128-bit compare-and-swap to update both words at once. Load is
+// lock-free and only retries while a write is in progress, but Store, Swap
+// and CompareAndSwap spin while another writer holds the counter, so
+// writers are serialized. The zero value holds the zero ULID. An AtomicULID
+// must not be copied after first use.
+//
+// An AtomicULID is safe for concurrent use.
+type AtomicULID struct {
+	// Compteur de séquence : impair pendant une écriture
+	seq    atomic.Uint64
+	hi, lo atomic.Uint64
+}
+
+// Load returns the current ULID.
+func (a *AtomicULID) Load() ULID {
+	for {
+		s := a.seq.Load()
+		if s&1 == 0 {
+			hi, lo := a.hi.Load(), a.lo.Load()
+			if a.seq.Load() == s {
+				return fromUint64s(hi, lo)
+			}
+		}
+		runtime.Gosched()
+	}
+}
+
+// Store sets the ULID to id.
+func (a *AtomicULID) Store(id ULID) {
+	s := a.lock()
+	a.store(id)
+	a.seq.Store(s + 1)
+}
+
+// Swap sets the ULID to id and returns the previous one.
+func (a *AtomicULID) Swap(id ULID) (old ULID) {
+	s := a.lock()
+	old = fromUint64s(a.hi.Load(), a.lo.Load())
+	a.store(id)
+	a.seq.Store(s + 1)
+	return old
+}
+
+// CompareAndSwap sets the ULID to newID if it is old, and reports whether
+// it did.
+func (a *AtomicULID) CompareAndSwap(old, newID ULID) bool {
+	s := a.lock()
+	ok := fromUint64s(a.hi.Load(), a.lo.Load()) == old
+	if ok {
+		a.store(newID)
+	}
+	a.seq.Store(s + 1)
+	return ok
+}
+
+// lock rend le compteur impair et retourne sa nouvelle valeur.
+func (a *AtomicULID) lock() uint64 {
+	for {
+		s := a.seq.Load()
+		if s&1 == 0 && a.seq.CompareAndSwap(s, s+1) {
+			return s + 1
+		}
+		runtime.Gosched()
+	}
+}
+
+func (a *AtomicULID) store(id ULID) {
+	a.hi.Store(binary.BigEndian.Uint64(id[:8]))
+	a.lo.Store(binary.BigEndian.Uint64(id[8:]))
+}
+
+func fromUint64s(hi, lo uint64) ULID {
+	var id ULID
+	binary.BigEndian.PutUint64(id[:8], hi)
+	binary.BigEndian.PutUint64(id[8:], lo)
+	return id
+}
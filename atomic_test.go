@@ -0,0 +1,82 @@
+package ulid
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomicULID(t *testing.T) {
+	var a AtomicULID
+	if !a.Load().IsZero() {
+		t.Errorf("Load() = %v, want zero", a.Load())
+	}
+
+	id1, id2 := MustNew(1, nil), MustNew(2, nil)
+	a.Store(id1)
+	if got := a.Load(); got != id1 {
+		t.Errorf("Load() = %v, want %v", got, id1)
+	}
+	if old := a.Swap(id2); old != id1 || a.Load() != id2 {
+		t.Errorf("Swap() = %v, then %v", old, a.Load())
+	}
+	if a.CompareAndSwap(id1, id1) || a.Load() != id2 {
+		t.Error("CompareAndSwap() with a stale old value should fail")
+	}
+	if !a.CompareAndSwap(id2, id1) || a.Load() != id1 {
+		t.Error("CompareAndSwap() with the current value should succeed")
+	}
+}
+
+func TestAtomicULIDConcurrent(t *testing.T) {
+	const workers, n = 4, 1000
+
+	// Les deux moitiés sont toujours écrites identiques : une lecture
+	// déchirée les rendrait différentes
+	var a AtomicULID
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				var id ULID
+				for j := range RawSize / 2 {
+					id[j], id[j+RawSize/2] = byte(w+i), byte(w+i)
+				}
+				a.Store(id)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				if id := a.Load(); [8]byte(id[:8]) != [8]byte(id[8:]) {
+					t.Errorf("Load() = %x, torn read", id[:])
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Suivi du maximum par CompareAndSwap
+	var latest AtomicULID
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				id := MustNew(uint64(i*workers+w), nil)
+				for {
+					old := latest.Load()
+					if !old.Less(id) || latest.CompareAndSwap(old, id) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if got := latest.Load().Time(); got != n*workers-1 {
+		t.Errorf("latest Time() = %d, want %d", got, n*workers-1)
+	}
+}
@@ -0,0 +1,115 @@
+package ulid
+
+import (
+	"fmt"
+	"iter"
+)
+
+// AuditReport summarizes the anomalies found in a stream of ULIDs.
+type AuditReport struct {
+	// Count is the number of ULIDs audited
+	Count int
+
+	// Duplicates holds every ULID seen more than once, once per repetition
+	Duplicates []ULID
+
+	// OutOfOrder counts the pairs of consecutive ULIDs where the second one
+	// is smaller than the first one
+	OutOfOrder int
+
+	// ClockRegressions counts the out of order pairs where the timestamp
+	// itself went backwards
+	ClockRegressions int
+
+	// Milliseconds is the number of distinct timestamps seen
+	Milliseconds int
+
+	// MaxBurst is the largest number of ULIDs sharing one timestamp, and
+	// MaxBurstTime that timestamp in Unix milliseconds
+	MaxBurst     int
+	MaxBurstTime uint64
+}
+
+// OK returns true if the stream had no duplicates and was strictly ordered.
+func (r AuditReport) OK() bool {
+	return len(r.Duplicates) == 0 && r.OutOfOrder == 0
+}
+
+// MeanBurst returns the average number of ULIDs per distinct timestamp.
+func (r AuditReport) MeanBurst() float64 {
+	if r.Milliseconds == 0 {
+		return 0
+	}
+	return float64(r.Count) / float64(r.Milliseconds)
+}
+
+// String returns a human-readable summary of the report.
+func (r AuditReport) String() string {
+	return fmt.Sprintf("count=%d duplicates=%d out_of_order=%d clock_regressions=%d milliseconds=%d max_burst=%d@%d mean_burst=%.2f",
+		r.Count, len(r.Duplicates), r.OutOfOrder, r.ClockRegressions, r.Milliseconds,
+		r.MaxBurst, r.MaxBurstTime, r.MeanBurst())
+}
+
+// Auditor consumes a stream of ULIDs, one at a time, and reports
+// duplicates, ordering violations and per-millisecond burst statistics.
+// It keeps every distinct ULID in memory.
+//
+// An Auditor is NOT safe for concurrent use.
+type Auditor struct {
+	seen   map[ULID]struct{}
+	bursts map[uint64]int
+	prev   ULID
+	report AuditReport
+}
+
+// NewAuditor returns an empty Auditor.
+func NewAuditor() *Auditor {
+	return &Auditor{
+		seen:   make(map[ULID]struct{}),
+		bursts: make(map[uint64]int),
+	}
+}
+
+// Add audits the next ULID of the stream.
+func (a *Auditor) Add(id ULID) {
+	r := &a.report
+	if _, ok := a.seen[id]; ok {
+		r.Duplicates = append(r.Duplicates, id)
+	} else {
+		a.seen[id] = struct{}{}
+	}
+
+	if r.Count > 0 && id.Compare(a.prev) < 0 {
+		r.OutOfOrder++
+		if id.Time() < a.prev.Time() {
+			r.ClockRegressions++
+		}
+	}
+
+	ms := id.Time()
+	n := a.bursts[ms] + 1
+	a.bursts[ms] = n
+	if n > r.MaxBurst {
+		r.MaxBurst, r.MaxBurstTime = n, ms
+	}
+
+	r.Count++
+	a.prev = id
+}
+
+// Report returns the report of the ULIDs audited so far.
+func (a *Auditor) Report() AuditReport {
+	r := a.report
+	r.Milliseconds = len(a.bursts)
+	r.Duplicates = append([]ULID(nil), r.Duplicates...)
+	return r
+}
+
+// Audit consumes ids and returns the audit report.
+func Audit(ids iter.Seq[ULID]) AuditReport {
+	a := NewAuditor()
+	for id := range ids {
+		a.Add(id)
+	}
+	return a.Report()
+}
@@ -0,0 +1,39 @@
+package ulid
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAudit(t *testing.T) {
+	a := MustNew(1000, nil)
+	b := MustNew(1000, nil)
+	c := MustNew(2000, nil)
+	if b.Less(a) {
+		a, b = b, a
+	}
+
+	// a b c is ordered, then a and b are replayed: going back to a is a
+	// clock regression, and both are duplicates
+	r := Audit(slices.Values([]ULID{a, b, c, a, b}))
+
+	if r.Count != 5 {
+		t.Errorf("Count = %v, want 5", r.Count)
+	}
+	if !slices.Equal(r.Duplicates, []ULID{a, b}) {
+		t.Errorf("Duplicates = %v, want %v", r.Duplicates, []ULID{a, b})
+	}
+	if r.OutOfOrder != 1 || r.ClockRegressions != 1 {
+		t.Errorf("OutOfOrder, ClockRegressions = %v, %v, want 1, 1", r.OutOfOrder, r.ClockRegressions)
+	}
+	if r.Milliseconds != 2 || r.MaxBurst != 4 || r.MaxBurstTime != 1000 {
+		t.Errorf("burst stats = %v", r)
+	}
+	if r.OK() {
+		t.Error("OK() = true for a stream with anomalies")
+	}
+
+	if r := Audit(slices.Values([]ULID{a, b, c})); !r.OK() {
+		t.Errorf("OK() = false for a clean stream: %v", r)
+	}
+}
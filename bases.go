@@ -0,0 +1,165 @@
+package ulid
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"math/bits"
+	"strings"
+)
+
+// Alternative text encodings of the 128 bits of a ULID. All of them have a
+// fixed length and round-trip exactly: DecodeX(id.EncodeX()) == id, and the
+// decoders reject any input that is not the output of the encoder for some
+// ULID, except for the case of hex digits.
+
+const (
+	// HexSize is the length of a hex encoded ULID
+	HexSize = 2 * RawSize
+
+	// Base58Size is the length of a base58 encoded ULID
+	Base58Size = 22
+
+	// Base64URLSize is the length of a base64url encoded ULID
+	Base64URLSize = 22
+)
+
+// Bitcoin alphabet, in ASCII order so fixed width encodings sort like ULIDs.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var base58Dec = func() (d [256]byte) {
+	for i := range d {
+		d[i] = 0xFF
+	}
+	for i := 0; i < len(base58Alphabet); i++ {
+		d[base58Alphabet[i]] = byte(i)
+	}
+	return d
+}()
+
+// EncodeHex returns the 32 lower case hex digits of the ULID. Like the
+// canonical encoding, it sorts like the ULIDs.
+func (id ULID) EncodeHex() string {
+	return hex.EncodeToString(id[:])
+}
+
+// DecodeHex decodes a ULID encoded by EncodeHex. Upper case digits are
+// accepted. ErrDataSize is returned if len(s) != HexSize and
+// ErrInvalidCharacters if s holds anything but hex digits.
+func DecodeHex(s string) (ULID, error) {
+	var id ULID
+	if len(s) != HexSize {
+		return id, ErrDataSize
+	}
+	if _, err := hex.Decode(id[:], []byte(s)); err != nil {
+		return ULID{}, ErrInvalidCharacters
+	}
+	return id, nil
+}
+
+// EncodeBase58 returns the ULID in base58 with the Bitcoin alphabet,
+// left-padded with '1' (the zero digit) to Base58Size characters so that
+// it sorts like the ULIDs.
+func (id ULID) EncodeBase58() string {
+	hi := binary.BigEndian.Uint64(id[:8])
+	lo := binary.BigEndian.Uint64(id[8:])
+
+	var buf [Base58Size]byte
+	for i := Base58Size - 1; i >= 0; i-- {
+		var r uint64
+		hi, r = bits.Div64(0, hi, 58)
+		lo, r = bits.Div64(r, lo, 58)
+		buf[i] = base58Alphabet[r]
+	}
+	return string(buf[:])
+}
+
+// DecodeBase58 decodes a ULID encoded by EncodeBase58. ErrDataSize is
+// returned if len(s) != Base58Size, ErrInvalidCharacters if s holds a
+// character outside of the alphabet and ErrOverflow if the value does not
+// fit in 128 bits.
+func DecodeBase58(s string) (ULID, error) {
+	if len(s) != Base58Size {
+		return ULID{}, ErrDataSize
+	}
+
+	var hi, lo uint64
+	for i := 0; i < len(s); i++ {
+		d := base58Dec[s[i]]
+		if d == 0xFF {
+			return ULID{}, ErrInvalidCharacters
+		}
+		// (hi, lo) = (hi, lo) * 58 + d
+		over, h := bits.Mul64(hi, 58)
+		carry, l := bits.Mul64(lo, 58)
+		l, c := bits.Add64(l, uint64(d), 0)
+		h, c2 := bits.Add64(h, carry, c)
+		if over != 0 || c2 != 0 {
+			return ULID{}, ErrOverflow
+		}
+		hi, lo = h, l
+	}
+
+	var id ULID
+	binary.BigEndian.PutUint64(id[:8], hi)
+	binary.BigEndian.PutUint64(id[8:], lo)
+	return id, nil
+}
+
+var base64URL = base64.RawURLEncoding.Strict()
+
+// EncodeBase64URL returns the ULID in unpadded base64url (RFC 4648). It is
+// the shortest URL-safe form, but unlike the other encodings it does not
+// sort like the ULIDs.
+func (id ULID) EncodeBase64URL() string {
+	return base64URL.EncodeToString(id[:])
+}
+
+// DecodeBase64URL decodes a ULID encoded by EncodeBase64URL. ErrDataSize is
+// returned if len(s) != Base64URLSize and ErrInvalidCharacters if s is not
+// in canonical unpadded base64url.
+func DecodeBase64URL(s string) (ULID, error) {
+	var id ULID
+	if len(s) != Base64URLSize {
+		return id, ErrDataSize
+	}
+	// Le décodeur ignore les retours à la ligne, qui ne sont pas canoniques
+	if strings.ContainsAny(s, "\r\n") {
+		return id, ErrInvalidCharacters
+	}
+	if _, err := base64URL.Decode(id[:], []byte(s)); err != nil {
+		return ULID{}, ErrInvalidCharacters
+	}
+	return id, nil
+}
+
+// ParseAny parses a ULID written in any of the forms supported by this
+// package, told apart by their length:
+//
+//   - the canonical form, see ParseStrict
+//   - the URN form, see ParseURN
+//   - the 8-4-4-4-12 form of UUIDs
+//   - hex, see DecodeHex
+//   - base64url, see DecodeBase64URL
+//
+// Base58 has the same length as base64url and is not detected.
+// ErrDataSize is returned if the length matches no form.
+func ParseAny(s string) (ULID, error) {
+	switch len(s) {
+	case EncodedSize:
+		return ParseStrict(s)
+	case len(URNPrefix) + EncodedSize:
+		return ParseURN(s)
+	case uuidSize:
+		id, ok := parseUUID([]byte(s))
+		if !ok {
+			return ULID{}, ErrInvalidCharacters
+		}
+		return id, nil
+	case HexSize:
+		return DecodeHex(s)
+	case Base64URLSize:
+		return DecodeBase64URL(s)
+	}
+	return ULID{}, ErrDataSize
+}
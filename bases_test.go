@@ -0,0 +1,146 @@
+package ulid
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestBases(t *testing.T) {
+	max := ULID{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+	tests := []struct {
+		id            ULID
+		hex, b58, b64 string
+	}{
+		{ULID{}, strings.Repeat("0", 32), strings.Repeat("1", 22), strings.Repeat("A", 22)},
+		{max, strings.Repeat("f", 32), "YcVfxkQb6JRzqk5kF2tNLv", strings.Repeat("_", 21) + "w"},
+		{ULID{15: 57}, strings.Repeat("0", 30) + "39", strings.Repeat("1", 21) + "z", strings.Repeat("A", 20) + "OQ"},
+	}
+	for _, tt := range tests {
+		if got := tt.id.EncodeHex(); got != tt.hex {
+			t.Errorf("EncodeHex(%x) = %s, want %s", tt.id[:], got, tt.hex)
+		}
+		if got := tt.id.EncodeBase58(); got != tt.b58 {
+			t.Errorf("EncodeBase58(%x) = %s, want %s", tt.id[:], got, tt.b58)
+		}
+		if got := tt.id.EncodeBase64URL(); got != tt.b64 {
+			t.Errorf("EncodeBase64URL(%x) = %s, want %s", tt.id[:], got, tt.b64)
+		}
+		if got, err := DecodeHex(strings.ToUpper(tt.hex)); err != nil || got != tt.id {
+			t.Errorf("DecodeHex(%s) = %v, %v, want %v", tt.hex, got, err, tt.id)
+		}
+		if got, err := DecodeBase58(tt.b58); err != nil || got != tt.id {
+			t.Errorf("DecodeBase58(%s) = %v, %v, want %v", tt.b58, got, err, tt.id)
+		}
+		if got, err := DecodeBase64URL(tt.b64); err != nil || got != tt.id {
+			t.Errorf("DecodeBase64URL(%s) = %v, %v, want %v", tt.b64, got, err, tt.id)
+		}
+	}
+}
+
+func TestBasesErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		decode func(string) (ULID, error)
+		s      string
+		err    error
+	}{
+		{"hex short", DecodeHex, strings.Repeat("0", 31), ErrDataSize},
+		{"hex chars", DecodeHex, strings.Repeat("g", 32), ErrInvalidCharacters},
+		{"base58 short", DecodeBase58, strings.Repeat("1", 21), ErrDataSize},
+		{"base58 chars", DecodeBase58, strings.Repeat("0", 22), ErrInvalidCharacters},
+		{"base58 overflow", DecodeBase58, "YcVfxkQb6JRzqk5kF2tNLw", ErrOverflow},
+		{"base58 max", DecodeBase58, strings.Repeat("z", 22), ErrOverflow},
+		{"base64 short", DecodeBase64URL, strings.Repeat("A", 21), ErrDataSize},
+		{"base64 chars", DecodeBase64URL, strings.Repeat("+", 22), ErrInvalidCharacters},
+		{"base64 trailing bits", DecodeBase64URL, strings.Repeat("A", 21) + "B", ErrInvalidCharacters},
+		{"base64 newline", DecodeBase64URL, strings.Repeat("A", 18) + "\r\nAA", ErrInvalidCharacters},
+	}
+	for _, tt := range tests {
+		if _, err := tt.decode(tt.s); err != tt.err {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestBasesSortable(t *testing.T) {
+	ids := make([]ULID, 100)
+	for i := range ids {
+		ids[i] = Make()
+	}
+	Sort(ids)
+	for _, enc := range []func(ULID) string{ULID.EncodeHex, ULID.EncodeBase58} {
+		s := make([]string, len(ids))
+		for i, id := range ids {
+			s[i] = enc(id)
+		}
+		if !slices.IsSorted(s) {
+			t.Errorf("encoding does not sort like the ULIDs: %v", s[:3])
+		}
+	}
+}
+
+func FuzzBases(f *testing.F) {
+	f.Add(make([]byte, RawSize))
+	f.Add([]byte("0123456789abcdef"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var id ULID
+		copy(id[:], data)
+
+		if got, err := DecodeHex(id.EncodeHex()); err != nil || got != id {
+			t.Fatalf("DecodeHex(EncodeHex(%x)) = %v, %v", id[:], got, err)
+		}
+		if got, err := DecodeBase58(id.EncodeBase58()); err != nil || got != id {
+			t.Fatalf("DecodeBase58(EncodeBase58(%x)) = %v, %v", id[:], got, err)
+		}
+		if got, err := DecodeBase64URL(id.EncodeBase64URL()); err != nil || got != id {
+			t.Fatalf("DecodeBase64URL(EncodeBase64URL(%x)) = %v, %v", id[:], got, err)
+		}
+
+		// Whatever decodes is canonical
+		s := string(data)
+		if id, err := DecodeBase58(s); err == nil && id.EncodeBase58() != s {
+			t.Fatalf("EncodeBase58(DecodeBase58(%q)) = %s", s, id.EncodeBase58())
+		}
+		if id, err := DecodeBase64URL(s); err == nil && id.EncodeBase64URL() != s {
+			t.Fatalf("EncodeBase64URL(DecodeBase64URL(%q)) = %s", s, id.EncodeBase64URL())
+		}
+		if id, err := DecodeHex(s); err == nil && id.EncodeHex() != strings.ToLower(s) {
+			t.Fatalf("EncodeHex(DecodeHex(%q)) = %s", s, id.EncodeHex())
+		}
+	})
+}
+
+func TestParseAny(t *testing.T) {
+	id := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	for _, s := range []string{
+		id.String(),
+		strings.ToLower(id.String()),
+		id.URN(),
+		uuidString(id),
+		strings.ToUpper(uuidString(id)),
+		id.EncodeHex(),
+		id.EncodeBase64URL(),
+	} {
+		if got, err := ParseAny(s); err != nil || got != id {
+			t.Errorf("ParseAny(%s) = %v, %v, want %v", s, got, err, id)
+		}
+	}
+
+	tests := []struct {
+		s   string
+		err error
+	}{
+		{"", ErrDataSize},
+		{"01AN4Z07BY79KA1307SR9X4MV", ErrDataSize},
+		{"01AN4Z07BY79KA1307SR9X4M!3", ErrInvalidCharacters},
+		{"urn:uuid:01AN4Z07BY79KA1307SR9X4MV3", ErrURN},
+		{"0156c9f0-1dfe-39d4-a20c-0e4e6d3d3df3"[:35] + "g", ErrInvalidCharacters},
+		{"0156c9f01dfe39d4a20c0e4e6d3d3df" + "g", ErrInvalidCharacters},
+	}
+	for _, tt := range tests {
+		if _, err := ParseAny(tt.s); err != tt.err {
+			t.Errorf("ParseAny(%q) error = %v, want %v", tt.s, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package ulid
+
+import (
+	"encoding/binary"
+	"errors"
+	"iter"
+)
+
+var (
+	// ErrBlockOrder is returned when appending a ULID to a Block that is not
+	// strictly greater than the last ULID of the block
+	ErrBlockOrder = errors.New("ulid: block append out of order")
+
+	// ErrBlockCorrupt is returned when unmarshaling a Block from malformed data
+	ErrBlockCorrupt = errors.New("ulid: corrupt block data")
+)
+
+// Block stores a sorted batch of ULIDs in a compact form. Timestamps are
+// delta-encoded as uvarints and entropy is kept raw, so time-clustered IDs
+// cost little more than their 10 bytes of entropy each.
+//
+// The zero value is an empty block ready to use. A Block is NOT safe for
+// concurrent use.
+type Block struct {
+	n       int
+	last    ULID
+	deltas  []byte // uvarint timestamp deltas, the first one relative to 0
+	entropy []byte // EntropySize bytes per ULID
+}
+
+// Len returns the number of ULIDs in the block.
+func (b *Block) Len() int {
+	return b.n
+}
+
+// Append adds id at the end of the block. ErrBlockOrder is returned if id
+// is not strictly greater than the last appended ULID.
+func (b *Block) Append(id ULID) error {
+	var prev uint64
+	if b.n > 0 {
+		if id.Compare(b.last) <= 0 {
+			return ErrBlockOrder
+		}
+		prev = b.last.Time()
+	}
+
+	b.deltas = binary.AppendUvarint(b.deltas, id.Time()-prev)
+	b.entropy = append(b.entropy, id[6:]...)
+	b.last = id
+	b.n++
+	return nil
+}
+
+// Contains returns true if id is stored in the block.
+func (b *Block) Contains(id ULID) bool {
+	if b.n == 0 || id.Compare(b.last) > 0 {
+		return false
+	}
+	for v := range b.All() {
+		switch v.Compare(id) {
+		case 0:
+			return true
+		case 1:
+			return false
+		}
+	}
+	return false
+}
+
+// All returns an iterator over the ULIDs of the block in ascending order.
+func (b *Block) All() iter.Seq[ULID] {
+	return func(yield func(ULID) bool) {
+		var ms uint64
+		deltas := b.deltas
+		for i := 0; i < b.n; i++ {
+			d, n := binary.Uvarint(deltas)
+			deltas = deltas[n:]
+			ms += d
+
+			var id ULID
+			_ = id.SetTime(ms)
+			copy(id[6:], b.entropy[i*EntropySize:])
+			if !yield(id) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate calls fn for each ULID of the block in ascending order, stopping
+// early if fn returns false.
+func (b *Block) Iterate(fn func(ULID) bool) {
+	b.All()(fn)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+//
+// The layout is uvarint(count), uvarint(len(deltas)), the deltas and
+// then the raw entropy.
+func (b *Block) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 2*binary.MaxVarintLen64+len(b.deltas)+len(b.entropy))
+	buf = binary.AppendUvarint(buf, uint64(b.n))
+	buf = binary.AppendUvarint(buf, uint64(len(b.deltas)))
+	buf = append(buf, b.deltas...)
+	buf = append(buf, b.entropy...)
+	return buf, nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// ErrBlockCorrupt is returned if data is not a valid block encoding.
+func (b *Block) UnmarshalBinary(data []byte) error {
+	count, n := binary.Uvarint(data)
+	if n <= 0 {
+		return ErrBlockCorrupt
+	}
+	data = data[n:]
+
+	size, n := binary.Uvarint(data)
+	if n <= 0 || size > uint64(len(data)-n) {
+		return ErrBlockCorrupt
+	}
+	data = data[n:]
+
+	deltas, entropy := data[:size], data[size:]
+	if count > uint64(len(entropy))/EntropySize || uint64(len(entropy)) != count*EntropySize {
+		return ErrBlockCorrupt
+	}
+
+	var nb Block
+	nb.deltas = append([]byte(nil), deltas...)
+	nb.entropy = append([]byte(nil), entropy...)
+	nb.n = int(count)
+
+	// Validate the deltas and the strict ordering Contains relies on, and
+	// recover the last ULID.
+	var ms uint64
+	for i := 0; i < nb.n; i++ {
+		d, n := binary.Uvarint(deltas)
+		if n <= 0 || ms+d > MaxTime || ms+d < ms {
+			return ErrBlockCorrupt
+		}
+		deltas = deltas[n:]
+		ms += d
+
+		var id ULID
+		_ = id.SetTime(ms)
+		copy(id[6:], nb.entropy[i*EntropySize:])
+		if i > 0 && id.Compare(nb.last) <= 0 {
+			return ErrBlockCorrupt
+		}
+		nb.last = id
+	}
+	if len(deltas) != 0 {
+		return ErrBlockCorrupt
+	}
+
+	*b = nb
+	return nil
+}
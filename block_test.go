@@ -0,0 +1,130 @@
+package ulid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestBlock(t *testing.T) {
+	ms := Timestamp(time.Now())
+	ids := []ULID{
+		MustNew(ms, nil),
+		MustNew(ms+1, nil),
+		MustNew(ms+1000, nil),
+	}
+	if ids[2].Compare(ids[1]) <= 0 || ids[1].Compare(ids[0]) <= 0 {
+		t.Fatal("test ULIDs are not sorted")
+	}
+
+	var b Block
+	for _, id := range ids {
+		if err := b.Append(id); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+	if err := b.Append(ids[0]); err != ErrBlockOrder {
+		t.Errorf("Append() out of order error = %v, want %v", err, ErrBlockOrder)
+	}
+	if b.Len() != 3 {
+		t.Errorf("Len() = %v, want 3", b.Len())
+	}
+
+	for _, id := range ids {
+		if !b.Contains(id) {
+			t.Errorf("Contains(%v) = false, want true", id)
+		}
+	}
+	if b.Contains(MustNew(ms+2, nil)) {
+		t.Error("Contains() = true for missing ULID")
+	}
+
+	i := 0
+	b.Iterate(func(id ULID) bool {
+		if id != ids[i] {
+			t.Errorf("Iterate()[%d] = %v, want %v", i, id, ids[i])
+		}
+		i++
+		return true
+	})
+	if i != len(ids) {
+		t.Errorf("Iterate() visited %d ULIDs, want %d", i, len(ids))
+	}
+}
+
+func TestBlockMarshalBinary(t *testing.T) {
+	var b Block
+	ms := Timestamp(time.Now())
+	for i := uint64(0); i < 100; i++ {
+		if err := b.Append(MustNew(ms+i, nil)); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+	}
+
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary() error = %v", err)
+	}
+	if len(data) >= 100*RawSize {
+		t.Errorf("MarshalBinary() length = %v, want less than %v", len(data), 100*RawSize)
+	}
+
+	var b2 Block
+	if err := b2.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary() error = %v", err)
+	}
+
+	var got, want []ULID
+	for id := range b.All() {
+		want = append(want, id)
+	}
+	for id := range b2.All() {
+		got = append(got, id)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("UnmarshalBinary() len = %v, want %v", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("UnmarshalBinary()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	// Appending after unmarshaling must keep the order invariant
+	if err := b2.Append(want[0]); err != ErrBlockOrder {
+		t.Errorf("Append() after UnmarshalBinary error = %v, want %v", err, ErrBlockOrder)
+	}
+
+	if err := b2.UnmarshalBinary(data[:len(data)-1]); err != ErrBlockCorrupt {
+		t.Errorf("UnmarshalBinary(truncated) error = %v, want %v", err, ErrBlockCorrupt)
+	}
+}
+
+func TestBlockUnmarshalOrder(t *testing.T) {
+	// Deux ULIDs de la même milliseconde, dont on inverse ou duplique
+	// l'entropie dans l'encodage
+	lo := MustNew(42, bytes.NewReader(bytes.Repeat([]byte{0x01}, EntropySize)))
+	hi := MustNew(42, bytes.NewReader(bytes.Repeat([]byte{0x02}, EntropySize)))
+	var b Block
+	if err := b.Append(lo); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Append(hi); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := b.MarshalBinary()
+
+	ent := data[len(data)-2*EntropySize:]
+	swapped := append([]byte(nil), data...)
+	copy(swapped[len(data)-2*EntropySize:], ent[EntropySize:])
+	copy(swapped[len(data)-EntropySize:], ent[:EntropySize])
+	dup := append([]byte(nil), data...)
+	copy(dup[len(data)-EntropySize:], ent[:EntropySize])
+
+	for name, d := range map[string][]byte{"swapped": swapped, "duplicate": dup} {
+		var b2 Block
+		if err := b2.UnmarshalBinary(d); err != ErrBlockCorrupt {
+			t.Errorf("UnmarshalBinary(%s) error = %v, want %v", name, err, ErrBlockCorrupt)
+		}
+	}
+}
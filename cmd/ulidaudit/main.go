@@ -0,0 +1,60 @@
+// Command ulidaudit reads text from the files given as arguments, or from
+// the standard input, extracts every ULID it contains and reports
+// duplicates, ordering violations and burst statistics.
+//
+// It exits with status 1 when duplicates or out of order IDs are found, and
+// with status 2 when an input cannot be read to the end.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func main() {
+	a := ulid.NewAuditor()
+
+	if len(os.Args) < 2 {
+		if err := audit(a, os.Stdin); err != nil {
+			fmt.Fprintln(os.Stderr, "ulidaudit: stdin:", err)
+			os.Exit(2)
+		}
+	}
+	for _, name := range os.Args[1:] {
+		f, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		err = audit(a, f)
+		f.Close()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ulidaudit: %s: %v\n", name, err)
+			os.Exit(2)
+		}
+	}
+
+	r := a.Report()
+	fmt.Println(r)
+	for _, id := range r.Duplicates {
+		fmt.Println("duplicate:", id)
+	}
+	if !r.OK() {
+		os.Exit(1)
+	}
+}
+
+// audit adds the ULIDs read from r to a, returning the read error that cut
+// the input short, if any.
+func audit(a *ulid.Auditor, r io.Reader) error {
+	for id, err := range ulid.ExtractAllErr(r) {
+		if err != nil {
+			return err
+		}
+		a.Add(id)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestAudit(t *testing.T) {
+	id := ulid.Make()
+
+	a := ulid.NewAuditor()
+	if err := audit(a, strings.NewReader("id="+id.String())); err != nil {
+		t.Errorf("audit() error = %v", err)
+	}
+	if r := a.Report(); r.Count != 1 || !r.OK() {
+		t.Errorf("audit() report = %v", r)
+	}
+
+	// Une entrée tronquée doit être signalée
+	a = ulid.NewAuditor()
+	r := io.MultiReader(strings.NewReader(id.String()+"\n"), iotest.ErrReader(io.ErrUnexpectedEOF))
+	if err := audit(a, r); err != io.ErrUnexpectedEOF {
+		t.Errorf("audit() error = %v, want %v", err, io.ErrUnexpectedEOF)
+	}
+}
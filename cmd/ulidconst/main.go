@@ -0,0 +1,116 @@
+// Command ulidconst turns ULID literals into Go array literals, so that
+// well-known IDs can be used in hot paths without parsing them at init time
+// and without the risk of a MustParse panic.
+//
+// Usage:
+//
+//	//go:generate go run github.com/kamalshkeir/ulid/cmd/ulidconst -package ids -output ids_gen.go Admin=01AN4Z07BY79KA1307SR9X4MV3
+//
+// generates
+//
+//	var Admin = ulid.ULID{0x01, 0x55, ...} // 01AN4Z07BY79KA1307SR9X4MV3
+//
+// The -type flag declares the variables with another type whose underlying
+// type is ulid.ULID, such as the ones generated by ulidgen.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func main() {
+	pkg := flag.String("package", os.Getenv("GOPACKAGE"), "package name of the generated file")
+	output := flag.String("output", "ulid_const_gen.go", "output file name")
+	typ := flag.String("type", "ulid.ULID", "type of the generated variables")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: ulidconst [flags] Name=ULID...")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *pkg == "" || flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	src, err := generate(*pkg, *typ, flag.Args())
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "ulidconst:", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "ulidconst:", err)
+		os.Exit(1)
+	}
+}
+
+type constant struct {
+	Name string
+	ID   ulid.ULID
+}
+
+// generate returns the formatted source declaring one variable per
+// Name=ULID definition.
+func generate(pkg, typ string, defs []string) ([]byte, error) {
+	consts := make([]constant, len(defs))
+	seen := make(map[string]bool, len(defs))
+	for i, def := range defs {
+		name, s, ok := strings.Cut(def, "=")
+		if !ok || !token.IsIdentifier(name) {
+			return nil, fmt.Errorf("invalid definition %q, want Name=ULID", def)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("duplicate name %q", name)
+		}
+		seen[name] = true
+
+		id, err := ulid.ParseStrict(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		consts[i] = constant{name, id}
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, struct {
+		Package string
+		Type    string
+		Import  bool
+		Consts  []constant
+	}{pkg, typ, strings.HasPrefix(typ, "ulid."), consts})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// literal returns the bytes of id as the elements of an array literal.
+func literal(id ulid.ULID) string {
+	var b strings.Builder
+	for i, c := range id {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%#02x", c)
+	}
+	return b.String()
+}
+
+var tmpl = template.Must(template.New("").Funcs(template.FuncMap{"literal": literal}).Parse(`// Code generated by ulidconst. DO NOT EDIT.
+
+package {{.Package}}
+{{if .Import}}
+import "github.com/kamalshkeir/ulid"
+{{end}}
+{{range .Consts}}
+var {{.Name}} = {{$.Type}}{ {{- literal .ID -}} } // {{.ID}}
+{{end}}`))
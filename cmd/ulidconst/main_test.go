@@ -0,0 +1,48 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	src, err := generate("ids", "ulid.ULID", []string{"Admin=01AN4Z07BY79KA1307SR9X4MV3"})
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	f, err := parser.ParseFile(token.NewFileSet(), "ids_gen.go", src, 0)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v", err)
+	}
+	if f.Name.Name != "ids" {
+		t.Errorf("package = %v, want ids", f.Name.Name)
+	}
+
+	want := "var Admin = ulid.ULID{0x01, 0x55, 0x49, 0xf0, 0x1d, 0x7e, 0x3a, 0x66, 0xa0, 0x8c, 0x07, 0xce, 0x13, 0xd2, 0x53, 0x63} // 01AN4Z07BY79KA1307SR9X4MV3"
+	if !strings.Contains(string(src), want) {
+		t.Errorf("generated code does not contain %q:\n%s", want, src)
+	}
+
+	// Type défini dans le paquet : pas d'import
+	src, err = generate("models", "UserID", []string{"Root=01AN4Z07BY79KA1307SR9X4MV3"})
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	if strings.Contains(string(src), "import") || !strings.Contains(string(src), "var Root = UserID{0x01,") {
+		t.Errorf("generate() with -type UserID =\n%s", src)
+	}
+
+	for _, defs := range [][]string{
+		{"Admin"},
+		{"1Admin=01AN4Z07BY79KA1307SR9X4MV3"},
+		{"Admin=01AN4Z07BY79KA1307SR9X4MV"},
+		{"Admin=01AN4Z07BY79KA1307SR9X4MV3", "Admin=01AN4Z07BY79KA1307SR9X4MV4"},
+	} {
+		if _, err := generate("ids", "ulid.ULID", defs); err == nil {
+			t.Errorf("generate(%q) should fail", defs)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	src, err := generate("models", "ID", []string{"User", "Order"})
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	f, err := parser.ParseFile(token.NewFileSet(), "ids_gen.go", src, 0)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v", err)
+	}
+	if f.Name.Name != "models" {
+		t.Errorf("package = %v, want models", f.Name.Name)
+	}
+
+	for _, want := range []string{"type UserID ulid.ULID", "func ParseOrderID(s string) (OrderID, error)", "func (id *UserID) Scan(src any) error"} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generated code does not contain %q", want)
+		}
+	}
+
+	if _, err := generate("models", "ID", []string{"user"}); err == nil {
+		t.Error("generate() should reject unexported names")
+	}
+}
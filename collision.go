@@ -0,0 +1,63 @@
+package ulid
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrDuplicate is returned by a Generator with a collision window when it
+// produces a ULID it already issued recently.
+var ErrDuplicate = errors.New("ulid: duplicate ULID generated")
+
+// WithCollisionWindow makes the Generator remember the last n ULIDs it
+// issued and refuse to issue one of them again, returning ErrDuplicate
+// after calling duplicateDetected, which may be nil, with the duplicate.
+//
+// A duplicate means that the entropy source repeats itself, as happens on
+// VMs restored from a snapshot or cloned with the state of their RNG. It is
+// meant for paranoid deployments: n ULIDs are kept in memory and checking
+// them serializes generation.
+func WithCollisionWindow(n int, duplicateDetected func(ULID)) Option {
+	return func(g *Generator) {
+		if n <= 0 {
+			g.window = nil
+			return
+		}
+		g.window = &window{
+			ring:     make([]ULID, 0, n),
+			seen:     make(map[ULID]struct{}, n),
+			detected: duplicateDetected,
+		}
+	}
+}
+
+// window garde les n derniers ULIDs dans un buffer circulaire.
+type window struct {
+	mu       sync.Mutex
+	ring     []ULID
+	pos      int
+	seen     map[ULID]struct{}
+	detected func(ULID)
+}
+
+func (w *window) check(id ULID) error {
+	w.mu.Lock()
+	if _, ok := w.seen[id]; ok {
+		w.mu.Unlock()
+		if w.detected != nil {
+			w.detected(id)
+		}
+		return ErrDuplicate
+	}
+
+	if len(w.ring) < cap(w.ring) {
+		w.ring = append(w.ring, id)
+	} else {
+		delete(w.seen, w.ring[w.pos])
+		w.ring[w.pos] = id
+		w.pos = (w.pos + 1) % len(w.ring)
+	}
+	w.seen[id] = struct{}{}
+	w.mu.Unlock()
+	return nil
+}
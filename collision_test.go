@@ -0,0 +1,53 @@
+package ulid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestGeneratorCollisionWindow(t *testing.T) {
+	now := time.Now()
+	var e [10]byte
+	e[9] = 1
+
+	// L'entropie se répète comme après la restauration d'un snapshot
+	entropy := bytes.NewReader(bytes.Repeat(e[:], 10))
+	var detected []ULID
+	g := NewGenerator(
+		WithEntropy(entropy),
+		WithCollisionWindow(2, func(id ULID) { detected = append(detected, id) }),
+	)
+
+	first, err := g.NewWithTime(now)
+	if err != nil {
+		t.Fatalf("NewWithTime() error = %v", err)
+	}
+	if _, err := g.NewWithTime(now); err != ErrDuplicate {
+		t.Errorf("NewWithTime() error = %v, want %v", err, ErrDuplicate)
+	}
+	if len(detected) != 1 || detected[0] != first {
+		t.Errorf("duplicateDetected called with %v, want [%v]", detected, first)
+	}
+	if g.Metrics().Generated() != 1 {
+		t.Errorf("Generated() = %d, want 1", g.Metrics().Generated())
+	}
+
+	// Once out of the window, the ULID is not remembered anymore
+	g.NewWithTime(now.Add(time.Millisecond))
+	g.NewWithTime(now.Add(2 * time.Millisecond))
+	if _, err := g.NewWithTime(now); err != nil {
+		t.Errorf("NewWithTime() out of window error = %v", err)
+	}
+}
+
+func TestGeneratorCollisionWindowUnique(t *testing.T) {
+	g := NewGenerator(WithMonotonic(), WithCollisionWindow(16, func(id ULID) {
+		t.Errorf("unexpected duplicate %v", id)
+	}))
+	for i := 0; i < 100; i++ {
+		if _, err := g.New(); err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Package cursor encodes keyset pagination cursors over ULID-sorted tables
+// into opaque, URL-safe tokens.
+//
+// A cursor holds the last ULID of a page, the paging direction and an
+// optional hash of the filters of the query, so a token cannot be replayed
+// against a different query:
+//
+//	h := cursor.HashFilters("status=active", "owner="+owner)
+//	token := cursor.Cursor{ID: last.ID, Direction: cursor.Forward, FilterHash: h}.Encode()
+//	...
+//	c, err := cursor.Decode(token)
+//	if err == nil {
+//		err = c.Check(h)
+//	}
+package cursor
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"hash/fnv"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+var (
+	// ErrInvalid is returned when decoding a malformed or corrupted token
+	ErrInvalid = errors.New("cursor: invalid token")
+
+	// ErrFilterMismatch is returned by Check when a cursor was created for
+	// a query with different filters
+	ErrFilterMismatch = errors.New("cursor: filters do not match")
+)
+
+// Direction is the paging direction of a cursor.
+type Direction uint8
+
+const (
+	// Forward pages towards greater ULIDs, i.e. id > cursor
+	Forward Direction = iota
+
+	// Backward pages towards smaller ULIDs, i.e. id < cursor
+	Backward
+)
+
+const (
+	version = 1
+
+	// version, direction, ULID, filter hash, CRC-32
+	size = 1 + 1 + ulid.RawSize + 8 + 4
+)
+
+// Cursor is a position in a ULID-sorted result set.
+type Cursor struct {
+	ID         ulid.ULID
+	Direction  Direction
+	FilterHash uint64
+}
+
+// HashFilters returns a hash of the given filter parts, to be stored in
+// Cursor.FilterHash. The order of the parts matters.
+func HashFilters(parts ...string) uint64 {
+	h := fnv.New64a()
+	for _, p := range parts {
+		h.Write([]byte(p))
+		h.Write([]byte{0})
+	}
+	return h.Sum64()
+}
+
+// Encode returns the cursor as an opaque, URL-safe token.
+func (c Cursor) Encode() string {
+	var buf [size]byte
+	buf[0] = version
+	buf[1] = byte(c.Direction)
+	copy(buf[2:], c.ID[:])
+	binary.BigEndian.PutUint64(buf[2+ulid.RawSize:], c.FilterHash)
+	binary.BigEndian.PutUint32(buf[size-4:], crc32.ChecksumIEEE(buf[:size-4]))
+	return base64.RawURLEncoding.EncodeToString(buf[:])
+}
+
+// Decode parses a token returned by Encode. ErrInvalid is returned if the
+// token is malformed or corrupted.
+func Decode(token string) (Cursor, error) {
+	var buf [size]byte
+	if base64.RawURLEncoding.DecodedLen(len(token)) != size {
+		return Cursor{}, ErrInvalid
+	}
+	if _, err := base64.RawURLEncoding.Decode(buf[:], []byte(token)); err != nil {
+		return Cursor{}, ErrInvalid
+	}
+	if buf[0] != version || Direction(buf[1]) > Backward ||
+		binary.BigEndian.Uint32(buf[size-4:]) != crc32.ChecksumIEEE(buf[:size-4]) {
+		return Cursor{}, ErrInvalid
+	}
+
+	c := Cursor{
+		Direction:  Direction(buf[1]),
+		FilterHash: binary.BigEndian.Uint64(buf[2+ulid.RawSize:]),
+	}
+	copy(c.ID[:], buf[2:])
+	return c, nil
+}
+
+// Check returns ErrFilterMismatch if the cursor was not created for a
+// query with the given filter hash.
+func (c Cursor) Check(filterHash uint64) error {
+	if c.FilterHash != filterHash {
+		return ErrFilterMismatch
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package cursor
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestEncodeDecode(t *testing.T) {
+	h := HashFilters("status=active", "owner=42")
+	c := Cursor{ID: ulid.Make(), Direction: Backward, FilterHash: h}
+
+	token := c.Encode()
+	if url.QueryEscape(token) != token {
+		t.Errorf("Encode() = %v, not URL-safe", token)
+	}
+
+	got, err := Decode(token)
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if got != c {
+		t.Errorf("Decode(Encode()) = %v, want %v", got, c)
+	}
+
+	if err := got.Check(h); err != nil {
+		t.Errorf("Check() error = %v", err)
+	}
+	if err := got.Check(HashFilters("status=deleted", "owner=42")); err != ErrFilterMismatch {
+		t.Errorf("Check() error = %v, want %v", err, ErrFilterMismatch)
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	token := Cursor{ID: ulid.Make()}.Encode()
+
+	tampered := []byte(token)
+	if tampered[5] == 'A' {
+		tampered[5] = 'B'
+	} else {
+		tampered[5] = 'A'
+	}
+
+	for _, s := range []string{"", "abc", token[1:], string(tampered), token + "AA"} {
+		if _, err := Decode(s); err != ErrInvalid {
+			t.Errorf("Decode(%q) error = %v, want %v", s, err, ErrInvalid)
+		}
+	}
+}
+
+func TestHashFilters(t *testing.T) {
+	if HashFilters("ab", "c") == HashFilters("a", "bc") {
+		t.Error("HashFilters() should separate parts")
+	}
+}
@@ -0,0 +1,84 @@
+package ulid
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// MaxLineSize is the size of the line buffer of a Decoder: longer lines,
+// counting surrounding whitespace and the newline, are rejected.
+const MaxLineSize = 1024
+
+// Decoder reads ULIDs from a stream holding one ID per line, either as
+// plain text or as a JSON string (NDJSON), the two forms being allowed in
+// the same stream. Blank lines are skipped. Its memory use does not depend
+// on the size of the stream:
+//
+//	d := ulid.NewDecoder(f)
+//	for d.Next() {
+//		id := d.ID()
+//	}
+//	if err := d.Err(); err != nil {
+//		...
+//	}
+//
+// Invalid lines stop the iteration with a *ListError.
+type Decoder struct {
+	s     *bufio.Scanner
+	index int
+	line  int
+	id    ULID
+	err   error
+}
+
+// NewDecoder returns a Decoder reading from r.
+func NewDecoder(r io.Reader) *Decoder {
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, MaxLineSize), MaxLineSize)
+	return &Decoder{s: s}
+}
+
+// Next advances to the next ULID, returning false at the end of the stream
+// or on error.
+func (d *Decoder) Next() bool {
+	for d.err == nil {
+		if !d.s.Scan() {
+			if err := d.s.Err(); err != nil {
+				d.err = &ListError{Index: d.index, Line: d.line + 1, Err: err}
+			}
+			return false
+		}
+		d.line++
+
+		b := bytes.TrimSpace(d.s.Bytes())
+		if len(b) == 0 {
+			continue
+		}
+		var err error
+		if b[0] == '"' || string(b) == "null" {
+			var r Required
+			err = r.UnmarshalJSON(b)
+			d.id = r.ULID
+		} else {
+			err = decode(&d.id, b, true)
+		}
+		if err != nil {
+			d.err = &ListError{Index: d.index, Line: d.line, Err: err}
+			return false
+		}
+		d.index++
+		return true
+	}
+	return false
+}
+
+// ID returns the current ULID.
+func (d *Decoder) ID() ULID {
+	return d.id
+}
+
+// Err returns the error met while decoding, if any.
+func (d *Decoder) Err() error {
+	return d.err
+}
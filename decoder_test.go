@@ -0,0 +1,76 @@
+package ulid
+
+import (
+	"bufio"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestDecoder(t *testing.T) {
+	a := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	b := MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	in := "01AN4Z07BY79KA1307SR9X4MV3\n\n  \"01ARZ3NDEKTSV4RRFFQ69G5FAV\"\r\n\t01an4z07by79ka1307sr9x4mv3"
+	d := NewDecoder(strings.NewReader(in))
+	var got []ULID
+	for d.Next() {
+		got = append(got, d.ID())
+	}
+	if err := d.Err(); err != nil {
+		t.Fatalf("Err() = %v", err)
+	}
+	if len(got) != 3 || got[0] != a || got[1] != b || got[2] != a {
+		t.Errorf("Decoder read %v, want [%v %v %v]", got, a, b, a)
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	tests := []struct {
+		in    string
+		index int
+		line  int
+		err   error
+	}{
+		{"01AN4Z07BY79KA1307SR9X4MV3\n\n01AN4Z07BY79KA1307SR9X4M!3\n", 1, 3, ErrInvalidCharacters},
+		{"null", 0, 1, ErrDataSize},
+		{"\"01AN4Z07BY79KA1307SR9X4MV\"", 0, 1, ErrDataSize},
+		{"01AN4Z07BY79KA1307SR9X4MV3\n" + strings.Repeat(" ", MaxLineSize+1), 1, 2, bufio.ErrTooLong},
+	}
+	for _, tt := range tests {
+		d := NewDecoder(strings.NewReader(tt.in))
+		n := 0
+		for d.Next() {
+			n++
+		}
+		var le *ListError
+		err := d.Err()
+		if !errors.As(err, &le) || !errors.Is(err, tt.err) {
+			t.Errorf("Decoder(%q) error = %v, want *ListError wrapping %v", tt.in, err, tt.err)
+			continue
+		}
+		if le.Index != tt.index || le.Line != tt.line || n != tt.index {
+			t.Errorf("Decoder(%q) error = %+v after %d IDs, want index %d line %d", tt.in, le, n, tt.index, tt.line)
+		}
+		if d.Next() {
+			t.Errorf("Decoder(%q).Next() = true after error", tt.in)
+		}
+	}
+}
+
+func BenchmarkDecoder(b *testing.B) {
+	var sb strings.Builder
+	for i := 0; i < 1000; i++ {
+		sb.WriteString(Make().String())
+		sb.WriteByte('\n')
+	}
+	in := sb.String()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		d := NewDecoder(strings.NewReader(in))
+		for d.Next() {
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package ulid
+
+import (
+	"sync/atomic"
+)
+
+// defaultGenerator est utilisé par Make et MakeWithTime s'il est défini.
+var defaultGenerator atomic.Pointer[Generator]
+
+// SetDefaultGenerator makes Make and MakeWithTime generate ULIDs with g, so
+// an application can enable monotonic, seeded or clock-controlled
+// generation globally:
+//
+//	ulid.SetDefaultGenerator(ulid.NewGenerator(ulid.WithMonotonic()))
+//
+// Passing nil restores the default: crypto/rand entropy and the system
+// clock. It is safe to call concurrently with generation.
+func SetDefaultGenerator(g *Generator) {
+	defaultGenerator.Store(g)
+}
+
+// DefaultGenerator returns the Generator set by SetDefaultGenerator, or nil
+// if none is set.
+func DefaultGenerator() *Generator {
+	return defaultGenerator.Load()
+}
+
+// entropyFailureHook est appelé par Make quand crypto/rand échoue.
+var entropyFailureHook atomic.Pointer[func(error)]
+
+// SetEntropyFailureHook registers fn to be called with the error when Make
+// fails to read crypto/rand, so that degraded ULIDs can be detected, e.g.
+// by logging or incrementing a metric. fn is called synchronously and must
+// be safe for concurrent use. Passing nil removes the hook.
+//
+// Since Go 1.24 crypto/rand.Read crashes the program instead of returning
+// errors, so the hook only fires with toolchains or targets where it can
+// still fail.
+func SetEntropyFailureHook(fn func(error)) {
+	if fn == nil {
+		entropyFailureHook.Store(nil)
+		return
+	}
+	entropyFailureHook.Store(&fn)
+}
+
+func entropyFailed(err error) {
+	if fn := entropyFailureHook.Load(); fn != nil {
+		(*fn)(err)
+	}
+}
+
+// monotonicGenerator est le générateur partagé de MakeMonotonic.
+var monotonicGenerator = NewGenerator(WithMonotonic())
+
+// MakeMonotonic returns a new ULID with the current time, strictly greater
+// than every ULID previously returned by MakeMonotonic in the process. It
+// is safe for concurrent use and panics if the monotonic entropy
+// overflows, see WithMonotonic.
+func MakeMonotonic() ULID {
+	return monotonicGenerator.Make()
+}
@@ -0,0 +1,96 @@
+package ulid
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestSetDefaultGenerator(t *testing.T) {
+	frozen := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	g := NewGenerator(WithMonotonic(), WithClock(ClockFunc(func() time.Time { return frozen })))
+
+	SetDefaultGenerator(g)
+	defer SetDefaultGenerator(nil)
+
+	if DefaultGenerator() != g {
+		t.Errorf("DefaultGenerator() = %p, want %p", DefaultGenerator(), g)
+	}
+
+	a, b := Make(), Make()
+	if a.Time() != Timestamp(frozen) || !a.Less(b) {
+		t.Errorf("Make() = %v, %v, want monotonic ULIDs at %v", a, b, frozen)
+	}
+	if c := MakeWithTime(frozen); !b.Less(c) {
+		t.Errorf("MakeWithTime() = %v, want greater than %v", c, b)
+	}
+	if g.Metrics().Generated() != 3 {
+		t.Errorf("Generated() = %d, want 3", g.Metrics().Generated())
+	}
+
+	SetDefaultGenerator(nil)
+	if DefaultGenerator() != nil {
+		t.Error("DefaultGenerator() should be nil after reset")
+	}
+	if id := Make(); id.Time() == Timestamp(frozen) {
+		t.Errorf("Make() after reset still uses the default generator")
+	}
+}
+
+func TestTryMake(t *testing.T) {
+	id, err := TryMake()
+	if err != nil || id.IsZero() {
+		t.Fatalf("TryMake() = %v, %v", id, err)
+	}
+
+	SetDefaultGenerator(NewGenerator(WithEntropy(bytes.NewReader(nil))))
+	defer SetDefaultGenerator(nil)
+	if _, err := TryMake(); err != io.EOF {
+		t.Errorf("TryMake() error = %v, want %v", err, io.EOF)
+	}
+}
+
+func TestSetEntropyFailureHook(t *testing.T) {
+	var got error
+	SetEntropyFailureHook(func(err error) { got = err })
+	entropyFailed(io.ErrUnexpectedEOF)
+	if got != io.ErrUnexpectedEOF {
+		t.Errorf("hook called with %v, want %v", got, io.ErrUnexpectedEOF)
+	}
+
+	got = nil
+	SetEntropyFailureHook(nil)
+	entropyFailed(io.ErrUnexpectedEOF)
+	if got != nil {
+		t.Errorf("hook called after removal with %v", got)
+	}
+}
+
+func TestMakeMonotonic(t *testing.T) {
+	const workers, n = 4, 1000
+	res := make(chan []ULID)
+	for w := 0; w < workers; w++ {
+		go func() {
+			ids := make([]ULID, n)
+			for i := range ids {
+				ids[i] = MakeMonotonic()
+			}
+			res <- ids
+		}()
+	}
+
+	seen := make(map[ULID]bool)
+	for w := 0; w < workers; w++ {
+		ids := <-res
+		for i, id := range ids {
+			if i > 0 && !ids[i-1].Less(id) {
+				t.Fatalf("MakeMonotonic() = %v after %v", id, ids[i-1])
+			}
+			if seen[id] {
+				t.Fatalf("MakeMonotonic() returned %v twice", id)
+			}
+			seen[id] = true
+		}
+	}
+}
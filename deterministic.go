@@ -0,0 +1,42 @@
+package ulid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"time"
+)
+
+// NewDeterministic returns a ULID derived from a namespace and a name, like
+// a UUIDv5: the same (ns, name) pair always gives the same ULID, so
+// idempotent imports can derive the ID of a record from its source key.
+//
+// The timestamp is the one of ns, so the IDs of a namespace sort together,
+// and the entropy is the start of HMAC-SHA256(ns, name).
+func NewDeterministic(ns ULID, name []byte) ULID {
+	mac := hmac.New(sha256.New, ns[:])
+	mac.Write(name)
+	var sum [sha256.Size]byte
+	mac.Sum(sum[:0])
+
+	id := ns
+	copy(id[6:], sum[:])
+	return id
+}
+
+// FromHash returns a ULID with the timestamp of t and the first 10 bytes
+// of the digest h as entropy, so an event store can derive the ID of an
+// event from its payload while keeping IDs sortable by time. Digests
+// shorter than 10 bytes are padded with zeros, and times outside the range
+// of ULIDs are clamped to it.
+//
+// Two payloads get the same ULID if they happen in the same millisecond
+// and their digests share the same first 80 bits. With a cryptographic
+// hash this only happens for identical payloads, which is the point: a
+// retried write maps to the same ID. Identical payloads in different
+// milliseconds get different IDs, and a weak or truncated hash raises the
+// odds of collisions between different payloads.
+func FromHash(t time.Time, h []byte) ULID {
+	id := FirstOfMillisecond(t)
+	copy(id[6:], h)
+	return id
+}
@@ -0,0 +1,53 @@
+package ulid
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+	"time"
+)
+
+func TestNewDeterministic(t *testing.T) {
+	ns := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	other := MustParse("01AN4Z07BY79KA1307SR9X4MV4")
+
+	a := NewDeterministic(ns, []byte("orders/42"))
+	if a != NewDeterministic(ns, []byte("orders/42")) {
+		t.Error("NewDeterministic() is not stable")
+	}
+	if a.Time() != ns.Time() {
+		t.Errorf("NewDeterministic().Time() = %d, want %d", a.Time(), ns.Time())
+	}
+	if a == NewDeterministic(ns, []byte("orders/43")) {
+		t.Error("NewDeterministic() should depend on the name")
+	}
+	if a == NewDeterministic(other, []byte("orders/42")) {
+		t.Error("NewDeterministic() should depend on the namespace")
+	}
+
+	// Fixed value, to catch accidental changes of the derivation
+	if got, want := a.String(), "01AN4Z07BY69H3CNJ6933QK0PK"; got != want {
+		t.Errorf("NewDeterministic() = %s, want %s", got, want)
+	}
+}
+
+func TestFromHash(t *testing.T) {
+	now := time.Now()
+	sum := sha256.Sum256([]byte(`{"event":"created"}`))
+
+	id := FromHash(now, sum[:])
+	if id.Time() != Timestamp(now) {
+		t.Errorf("FromHash().Time() = %d, want %d", id.Time(), Timestamp(now))
+	}
+	if !bytes.Equal(id.Entropy(), sum[:10]) {
+		t.Errorf("FromHash().Entropy() = %x, want %x", id.Entropy(), sum[:10])
+	}
+	if FromHash(now, sum[:]) != id {
+		t.Error("FromHash() is not stable")
+	}
+
+	short := FromHash(now, []byte{1, 2})
+	if !bytes.Equal(short.Entropy(), []byte{1, 2, 0, 0, 0, 0, 0, 0, 0, 0}) {
+		t.Errorf("FromHash(short).Entropy() = %x", short.Entropy())
+	}
+}
@@ -0,0 +1,131 @@
+// Package diag runs basic statistical tests over the entropy of generated
+// ULIDs. It is meant as a startup health check on platforms where the
+// random source may be misconfigured, not as a cryptographic evaluation.
+package diag
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+// DefaultSamples is the number of ULIDs generated when Options.Samples is 0
+const DefaultSamples = 10000
+
+// Approximate chi-square bounds for 255 degrees of freedom at the 0.01% and
+// 99.99% quantiles. Values outside indicate a biased or too regular source.
+const (
+	chiSquareMin = 170.0
+	chiSquareMax = 340.0
+)
+
+// Options configures Run.
+type Options struct {
+	// Samples is the number of ULIDs to generate, DefaultSamples if 0
+	Samples int
+
+	// Generate returns the ULIDs to test, ulid.Make if nil
+	Generate func() ulid.ULID
+}
+
+// Report holds the results of Run.
+type Report struct {
+	Samples int
+	Bytes   int
+
+	// Frequency counts the occurrences of every entropy byte value
+	Frequency [256]int
+
+	// Mean is the arithmetic mean of the entropy bytes, ideally 127.5
+	Mean   float64
+	MeanOK bool
+
+	// ChiSquare is the chi-square statistic of the byte frequencies
+	ChiSquare   float64
+	ChiSquareOK bool
+
+	// SerialCorrelation is the correlation between consecutive bytes,
+	// ideally close to 0
+	SerialCorrelation   float64
+	SerialCorrelationOK bool
+}
+
+// OK returns true if every test passed.
+func (r Report) OK() bool {
+	return r.MeanOK && r.ChiSquareOK && r.SerialCorrelationOK
+}
+
+// String returns a human-readable summary of the report.
+func (r Report) String() string {
+	return fmt.Sprintf("samples=%d bytes=%d mean=%.3f(%s) chi2=%.2f(%s) serial=%.5f(%s)",
+		r.Samples, r.Bytes, r.Mean, status(r.MeanOK), r.ChiSquare, status(r.ChiSquareOK),
+		r.SerialCorrelation, status(r.SerialCorrelationOK))
+}
+
+func status(ok bool) string {
+	if ok {
+		return "ok"
+	}
+	return "FAIL"
+}
+
+// Run generates the configured number of ULIDs and tests their 10 entropy
+// bytes each.
+func Run(opts Options) Report {
+	if opts.Samples <= 0 {
+		opts.Samples = DefaultSamples
+	}
+	if opts.Generate == nil {
+		opts.Generate = ulid.Make
+	}
+
+	data := make([]byte, 0, opts.Samples*10)
+	for i := 0; i < opts.Samples; i++ {
+		id := opts.Generate()
+		data = append(data, id[6:]...)
+	}
+	return Analyze(data)
+}
+
+// Analyze runs the tests over raw entropy bytes.
+func Analyze(data []byte) Report {
+	r := Report{Bytes: len(data), Samples: len(data) / 10}
+	if len(data) < 2 {
+		return r
+	}
+	n := float64(len(data))
+
+	var sum float64
+	for _, b := range data {
+		r.Frequency[b]++
+		sum += float64(b)
+	}
+
+	// Moyenne : écart-type d'un octet uniforme ~73.9
+	r.Mean = sum / n
+	r.MeanOK = math.Abs(r.Mean-127.5) < 4*73.9/math.Sqrt(n)
+
+	// Chi-carré sur les 256 valeurs possibles
+	expected := n / 256
+	for _, c := range r.Frequency {
+		d := float64(c) - expected
+		r.ChiSquare += d * d / expected
+	}
+	r.ChiSquareOK = r.ChiSquare > chiSquareMin && r.ChiSquare < chiSquareMax
+
+	// Corrélation sérielle entre octets consécutifs (circulaire)
+	var t1, t2, t3 float64
+	for i, b := range data {
+		x, y := float64(b), float64(data[(i+1)%len(data)])
+		t1 += x * y
+		t2 += x
+		t3 += x * x
+	}
+	if den := n*t3 - t2*t2; den != 0 {
+		r.SerialCorrelation = (n*t1 - t2*t2) / den
+	}
+	r.SerialCorrelationOK = math.Abs(r.SerialCorrelation) < 4/math.Sqrt(n)
+
+	return r
+}
@@ -0,0 +1,47 @@
+package diag
+
+import (
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestRun(t *testing.T) {
+	r := Run(Options{})
+	if r.Samples != DefaultSamples || r.Bytes != DefaultSamples*10 {
+		t.Errorf("Samples, Bytes = %v, %v", r.Samples, r.Bytes)
+	}
+	if !r.OK() {
+		t.Errorf("Run() with crypto/rand failed: %v", r)
+	}
+}
+
+func TestRunBrokenSource(t *testing.T) {
+	r := Run(Options{
+		Samples:  1000,
+		Generate: func() ulid.ULID { return ulid.MustNew(0, zeroReader{}) },
+	})
+	if r.OK() || r.MeanOK || r.ChiSquareOK {
+		t.Errorf("Run() with a zero source passed: %v", r)
+	}
+
+	var counter byte
+	r = Analyze(func() []byte {
+		b := make([]byte, 10000)
+		for i := range b {
+			b[i] = counter
+			counter++
+		}
+		return b
+	}())
+	if r.SerialCorrelationOK && r.ChiSquareOK {
+		t.Errorf("Analyze() of a counter passed: %v", r)
+	}
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
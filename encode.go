@@ -0,0 +1,75 @@
+package ulid
+
+import (
+	"encoding/binary"
+)
+
+// encodeTextBytes encodes id into dst, len(dst) == EncodedSize, one byte
+// and one table lookup per character.
+func encodeTextBytes(dst []byte, id *ULID) {
+	_ = dst[EncodedSize-1]
+
+	// 10 byte timestamp
+	dst[0] = enc[(id[0]&224)>>5]
+	dst[1] = enc[id[0]&31]
+	dst[2] = enc[(id[1]&248)>>3]
+	dst[3] = enc[((id[1]&7)<<2)|((id[2]&192)>>6)]
+	dst[4] = enc[(id[2]&62)>>1]
+	dst[5] = enc[((id[2]&1)<<4)|((id[3]&240)>>4)]
+	dst[6] = enc[((id[3]&15)<<1)|((id[4]&128)>>7)]
+	dst[7] = enc[(id[4]&124)>>2]
+	dst[8] = enc[((id[4]&3)<<3)|((id[5]&224)>>5)]
+	dst[9] = enc[id[5]&31]
+
+	// 16 bytes of entropy
+	dst[10] = enc[(id[6]&248)>>3]
+	dst[11] = enc[((id[6]&7)<<2)|((id[7]&192)>>6)]
+	dst[12] = enc[(id[7]&62)>>1]
+	dst[13] = enc[((id[7]&1)<<4)|((id[8]&240)>>4)]
+	dst[14] = enc[((id[8]&15)<<1)|((id[9]&128)>>7)]
+	dst[15] = enc[(id[9]&124)>>2]
+	dst[16] = enc[((id[9]&3)<<3)|((id[10]&224)>>5)]
+	dst[17] = enc[id[10]&31]
+	dst[18] = enc[(id[11]&248)>>3]
+	dst[19] = enc[((id[11]&7)<<2)|((id[12]&192)>>6)]
+	dst[20] = enc[(id[12]&62)>>1]
+	dst[21] = enc[((id[12]&1)<<4)|((id[13]&240)>>4)]
+	dst[22] = enc[((id[13]&15)<<1)|((id[14]&128)>>7)]
+	dst[23] = enc[(id[14]&124)>>2]
+	dst[24] = enc[((id[14]&3)<<3)|((id[15]&224)>>5)]
+	dst[25] = enc[id[15]&31]
+}
+
+// enc2 maps 10 bits to their two base32 characters, stored as a little
+// endian uint16 ready to be written in one store.
+var enc2 = func() (t [1024]uint16) {
+	for i := range t {
+		t[i] = uint16(enc[i>>5]) | uint16(enc[i&31])<<8
+	}
+	return t
+}()
+
+// encodeTextPairs encodes id into dst, len(dst) == EncodedSize, from two
+// 64-bit loads, writing two characters per table lookup.
+func encodeTextPairs(dst []byte, id *ULID) {
+	_ = dst[EncodedSize-1]
+	hi := binary.BigEndian.Uint64(id[:8])
+	lo := binary.BigEndian.Uint64(id[8:])
+
+	// 26 caractères = 13 paires de 10 bits ; le premier caractère n'a que
+	// 3 bits utiles, la 7e paire chevauche hi et lo.
+	le := binary.LittleEndian
+	le.PutUint16(dst[0:], enc2[hi>>56])
+	le.PutUint16(dst[2:], enc2[(hi>>46)&1023])
+	le.PutUint16(dst[4:], enc2[(hi>>36)&1023])
+	le.PutUint16(dst[6:], enc2[(hi>>26)&1023])
+	le.PutUint16(dst[8:], enc2[(hi>>16)&1023])
+	le.PutUint16(dst[10:], enc2[(hi>>6)&1023])
+	le.PutUint16(dst[12:], enc2[(hi&63)<<4|lo>>60])
+	le.PutUint16(dst[14:], enc2[(lo>>50)&1023])
+	le.PutUint16(dst[16:], enc2[(lo>>40)&1023])
+	le.PutUint16(dst[18:], enc2[(lo>>30)&1023])
+	le.PutUint16(dst[20:], enc2[(lo>>20)&1023])
+	le.PutUint16(dst[22:], enc2[(lo>>10)&1023])
+	le.PutUint16(dst[24:], enc2[lo&1023])
+}
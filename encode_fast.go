@@ -0,0 +1,9 @@
+//go:build (amd64 || arm64) && !purego
+
+package ulid
+
+// encodeText uses 64-bit loads and pair lookups on 64-bit architectures
+// with cheap unaligned stores.
+func encodeText(dst []byte, id *ULID) {
+	encodeTextPairs(dst, id)
+}
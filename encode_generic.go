@@ -0,0 +1,7 @@
+//go:build !(amd64 || arm64) || purego
+
+package ulid
+
+func encodeText(dst []byte, id *ULID) {
+	encodeTextBytes(dst, id)
+}
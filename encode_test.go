@@ -0,0 +1,41 @@
+package ulid
+
+import (
+	"bytes"
+	"testing"
+	"testing/quick"
+)
+
+func TestEncodeTextPairs(t *testing.T) {
+	check := func(id ULID) bool {
+		var a, b [EncodedSize]byte
+		encodeTextBytes(a[:], &id)
+		encodeTextPairs(b[:], &id)
+		return a == b
+	}
+	if err := quick.Check(check, &quick.Config{MaxCount: 10000}); err != nil {
+		t.Error(err)
+	}
+
+	max := ULID(bytes.Repeat([]byte{0xFF}, RawSize))
+	for _, id := range []ULID{{}, max} {
+		if !check(id) {
+			t.Errorf("encodeTextPairs(%x) differs from encodeTextBytes", id[:])
+		}
+	}
+}
+
+func BenchmarkEncodeText(b *testing.B) {
+	id := Make()
+	var dst [EncodedSize]byte
+	b.Run("bytes", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			encodeTextBytes(dst[:], &id)
+		}
+	})
+	b.Run("pairs", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			encodeTextPairs(dst[:], &id)
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package ulid
+
+// MessagePack and CBOR encodings of the 16 byte binary form, compatible with
+// the Marshaler/Unmarshaler interfaces of vmihailenco/msgpack and
+// fxamacker/cbor. They cost 18 and 17 bytes per ULID instead of the 28 of
+// the text form.
+
+const (
+	msgpackBin8 = 0xc4
+	msgpackNil  = 0xc0
+
+	cborBytes16 = 0x40 | RawSize // major type 2, length 16
+	cborBytes8  = 0x58           // major type 2, 1 byte length
+	cborNull    = 0xf6
+)
+
+// AppendMsgpack appends the MessagePack bin 8 encoding of the ULID to dst.
+func (id ULID) AppendMsgpack(dst []byte) []byte {
+	dst = append(dst, msgpackBin8, RawSize)
+	return append(dst, id[:]...)
+}
+
+// MarshalMsgpack returns the MessagePack bin 8 encoding of the ULID.
+func (id ULID) MarshalMsgpack() ([]byte, error) {
+	return id.AppendMsgpack(make([]byte, 0, 2+RawSize)), nil
+}
+
+// UnmarshalMsgpack decodes a MessagePack bin 8 encoded ULID. A MessagePack
+// nil decodes to the zero ULID. ErrDataSize is returned for any other input.
+func (id *ULID) UnmarshalMsgpack(data []byte) error {
+	if len(data) == 1 && data[0] == msgpackNil {
+		*id = ULID{}
+		return nil
+	}
+	if len(data) != 2+RawSize || data[0] != msgpackBin8 || data[1] != RawSize {
+		return ErrDataSize
+	}
+	copy(id[:], data[2:])
+	return nil
+}
+
+// AppendCBOR appends the CBOR byte string encoding of the ULID to dst.
+func (id ULID) AppendCBOR(dst []byte) []byte {
+	dst = append(dst, cborBytes16)
+	return append(dst, id[:]...)
+}
+
+// MarshalCBOR returns the CBOR byte string encoding of the ULID.
+func (id ULID) MarshalCBOR() ([]byte, error) {
+	return id.AppendCBOR(make([]byte, 0, 1+RawSize)), nil
+}
+
+// UnmarshalCBOR decodes a CBOR byte string encoded ULID. A CBOR null
+// decodes to the zero ULID. ErrDataSize is returned for any other input.
+func (id *ULID) UnmarshalCBOR(data []byte) error {
+	if len(data) == 1 && data[0] == cborNull {
+		*id = ULID{}
+		return nil
+	}
+	switch {
+	case len(data) == 1+RawSize && data[0] == cborBytes16:
+		copy(id[:], data[1:])
+	case len(data) == 2+RawSize && data[0] == cborBytes8 && data[1] == RawSize:
+		// Forme non canonique avec la longueur sur 1 octet
+		copy(id[:], data[2:])
+	default:
+		return ErrDataSize
+	}
+	return nil
+}
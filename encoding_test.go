@@ -0,0 +1,61 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestMsgpack(t *testing.T) {
+	id := Make()
+	data, err := id.MarshalMsgpack()
+	if err != nil {
+		t.Fatalf("MarshalMsgpack() error = %v", err)
+	}
+	if len(data) != 18 || data[0] != 0xc4 || data[1] != 16 {
+		t.Errorf("MarshalMsgpack() = %x", data)
+	}
+
+	var id2 ULID
+	if err := id2.UnmarshalMsgpack(data); err != nil {
+		t.Fatalf("UnmarshalMsgpack() error = %v", err)
+	}
+	if id2 != id {
+		t.Errorf("UnmarshalMsgpack(MarshalMsgpack()) = %v, want %v", id2, id)
+	}
+
+	if err := id2.UnmarshalMsgpack([]byte{0xc0}); err != nil || !id2.IsZero() {
+		t.Errorf("UnmarshalMsgpack(nil) = %v, %v", id2, err)
+	}
+	if err := id2.UnmarshalMsgpack(data[:10]); err != ErrDataSize {
+		t.Errorf("UnmarshalMsgpack(short) error = %v, want %v", err, ErrDataSize)
+	}
+}
+
+func TestCBOR(t *testing.T) {
+	id := Make()
+	data, err := id.MarshalCBOR()
+	if err != nil {
+		t.Fatalf("MarshalCBOR() error = %v", err)
+	}
+	if len(data) != 17 || data[0] != 0x50 {
+		t.Errorf("MarshalCBOR() = %x", data)
+	}
+
+	var id2 ULID
+	if err := id2.UnmarshalCBOR(data); err != nil {
+		t.Fatalf("UnmarshalCBOR() error = %v", err)
+	}
+	if id2 != id {
+		t.Errorf("UnmarshalCBOR(MarshalCBOR()) = %v, want %v", id2, id)
+	}
+
+	if err := id2.UnmarshalCBOR([]byte{0xf6}); err != nil || !id2.IsZero() {
+		t.Errorf("UnmarshalCBOR(null) = %v, %v", id2, err)
+	}
+	id2 = ULID{}
+	if err := id2.UnmarshalCBOR(append([]byte{0x58, 16}, id[:]...)); err != nil || id2 != id {
+		t.Errorf("UnmarshalCBOR(long form) = %v, %v, want %v", id2, err, id)
+	}
+	if err := id2.UnmarshalCBOR(append([]byte{0x58, 15}, id[:15]...)); err != ErrDataSize {
+		t.Errorf("UnmarshalCBOR(invalid) error = %v, want %v", err, ErrDataSize)
+	}
+}
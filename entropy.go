@@ -0,0 +1,61 @@
+package ulid
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// entropySource remplace crypto/rand s'il est défini.
+var entropySource atomic.Pointer[lockedSource]
+
+type lockedSource struct {
+	mu sync.Mutex
+	r  io.Reader
+}
+
+// SetEntropySource makes the functions of this package using crypto/rand by
+// default read their randomness from r instead: Make, TryMake, MakeShort,
+// MakeX, and New, NewShort, NewX, MonotonicReader and NewMonoReader with a
+// nil entropy. It is meant for platforms where crypto/rand is missing,
+// blocks or fails, e.g. wasm hosts exposing only crypto.getRandomValues or
+// TinyGo targets without an RNG driver. Reads are serialized, so r does
+// not need to be safe for concurrent use. Passing nil restores crypto/rand.
+//
+// Generators are not affected, see WithEntropy.
+func SetEntropySource(r io.Reader) {
+	if r == nil {
+		entropySource.Store(nil)
+		return
+	}
+	entropySource.Store(&lockedSource{r: r})
+}
+
+// readRandom lit l'entropie par défaut. Le chemin crypto/rand reste direct
+// pour ne pas faire échapper b.
+func readRandom(b []byte) (int, error) {
+	if s := entropySource.Load(); s != nil {
+		return s.read(b)
+	}
+	return rand.Read(b)
+}
+
+// defaultReader est l'io.Reader de readRandom, pour les API qui en
+// attendent un.
+type defaultReader struct{}
+
+func (defaultReader) Read(b []byte) (int, error) {
+	return readRandom(b)
+}
+
+//go:noinline
+func (s *lockedSource) read(b []byte) (int, error) {
+	// Copie pour que b n'échappe pas via l'interface
+	buf := make([]byte, len(b))
+	s.mu.Lock()
+	n, err := io.ReadFull(s.r, buf)
+	s.mu.Unlock()
+	copy(b, buf[:n])
+	return n, err
+}
@@ -0,0 +1,17 @@
+//go:build !race
+
+package ulid
+
+import "testing"
+
+// crypto/rand allocates under -race, so the zero-alloc guarantee is only
+// checked without it.
+
+func TestMakeAllocs(t *testing.T) {
+	if n := testing.AllocsPerRun(100, func() { _ = Make() }); n != 0 {
+		t.Errorf("Make() allocs = %v, want 0", n)
+	}
+	if n := testing.AllocsPerRun(100, func() { _, _ = New(1, nil) }); n != 0 {
+		t.Errorf("New(1, nil) allocs = %v, want 0", n)
+	}
+}
@@ -0,0 +1,62 @@
+package ulid
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestSetEntropySource(t *testing.T) {
+	SetEntropySource(bytes.NewReader(bytes.Repeat([]byte{0xAB}, 2*EntropySize)))
+	defer SetEntropySource(nil)
+
+	want := [EntropySize]byte(bytes.Repeat([]byte{0xAB}, EntropySize))
+	if id := Make(); id.EntropyArray() != want {
+		t.Errorf("Make() entropy = %x, want %x", id.Entropy(), want)
+	}
+	if id := MustNew(1, nil); id.EntropyArray() != want {
+		t.Errorf("MustNew(1, nil) entropy = %x, want %x", id.Entropy(), want)
+	}
+	if _, err := TryMake(); err != io.EOF {
+		t.Errorf("TryMake() error = %v, want %v", err, io.EOF)
+	}
+
+	SetEntropySource(nil)
+	if id := Make(); id.EntropyArray() == want {
+		t.Errorf("Make() after reset still uses the source")
+	}
+}
+
+func TestSetEntropySourceOtherIDs(t *testing.T) {
+	r := bytes.NewReader(bytes.Repeat([]byte{0xAB}, 64))
+	SetEntropySource(r)
+	defer SetEntropySource(nil)
+
+	if s := MakeShort(); uint64(s)&(1<<ShortBits-1) != 0x2BABAB {
+		t.Errorf("MakeShort() low bits = %#x, want 0x2babab", uint64(s)&(1<<ShortBits-1))
+	}
+	if s, err := NewShort(Timestamp(time.Now()), nil); err != nil || uint64(s)&(1<<ShortBits-1) != 0x2BABAB {
+		t.Errorf("NewShort(nil) = %v, %v", s, err)
+	}
+	if x, err := NewX(1, nil); err != nil || !bytes.Equal(x[8:], bytes.Repeat([]byte{0xAB}, XRawSize-8)) {
+		t.Errorf("NewX(nil) = %x, %v", x[:], err)
+	}
+	before := r.Len()
+	NewMonoReader(1, nil)
+	if r.Len() != before-8 {
+		t.Errorf("NewMonoReader(nil) read %d bytes from the source, want 8", before-r.Len())
+	}
+
+	// Source épuisée : MakeShort le signale au hook
+	var got error
+	SetEntropyFailureHook(func(err error) { got = err })
+	defer SetEntropyFailureHook(nil)
+	SetEntropySource(bytes.NewReader(nil))
+	if s := MakeShort(); uint64(s)&(1<<ShortBits-1) != 0 || got != io.EOF {
+		t.Errorf("MakeShort() = %v with hook error %v, want zero low bits and %v", s, got, io.EOF)
+	}
+	if _, err := NewShort(Timestamp(time.Now()), nil); err != io.EOF {
+		t.Errorf("NewShort(nil) error = %v, want %v", err, io.EOF)
+	}
+}
@@ -0,0 +1,66 @@
+// Package entulid provides ent schema fields holding ulid.ULID values,
+// stored either as their 26 character string or as 16 raw bytes.
+//
+//	func (User) Fields() []ent.Field {
+//		return []ent.Field{
+//			entulid.Text("id"),
+//			entulid.Binary("session_id"),
+//		}
+//	}
+//
+// For other field options, build the field with field.String or
+// field.Bytes and GoType(ulid.ULID{}), using BinaryValueScanner for the
+// binary form.
+package entulid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/field"
+	"github.com/kamalshkeir/ulid"
+)
+
+// BinaryValueScanner stores ulid.ULID fields as 16 raw bytes. A NULL scans
+// to the zero ULID.
+var BinaryValueScanner = field.ValueScannerFunc[ulid.ULID, *sql.Null[[]byte]]{
+	V: func(id ulid.ULID) (driver.Value, error) {
+		return id.MarshalBinary()
+	},
+	S: func(v *sql.Null[[]byte]) (ulid.ULID, error) {
+		var id ulid.ULID
+		if !v.Valid {
+			return id, nil
+		}
+		return id, id.UnmarshalBinary(v.V)
+	},
+}
+
+// Text returns an immutable field storing ULIDs as char(26) strings,
+// defaulting to ulid.Make for new entities.
+func Text(name string) ent.Field {
+	return field.String(name).
+		GoType(ulid.ULID{}).
+		SchemaType(map[string]string{
+			dialect.MySQL:    "char(26)",
+			dialect.Postgres: "char(26)",
+		}).
+		DefaultFunc(ulid.Make).
+		Immutable()
+}
+
+// Binary returns an immutable field storing ULIDs as 16 raw bytes,
+// defaulting to ulid.Make for new entities.
+func Binary(name string) ent.Field {
+	return field.Bytes(name).
+		GoType(ulid.ULID{}).
+		ValueScanner(BinaryValueScanner).
+		SchemaType(map[string]string{
+			dialect.MySQL:    "binary(16)",
+			dialect.Postgres: "bytea",
+		}).
+		DefaultFunc(ulid.Make).
+		Immutable()
+}
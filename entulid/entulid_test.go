@@ -0,0 +1,56 @@
+package entulid
+
+import (
+	"bytes"
+	"database/sql"
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestFields(t *testing.T) {
+	text := Text("id").Descriptor()
+	if text.Err != nil {
+		t.Fatalf("Text() error = %v", text.Err)
+	}
+	if !text.Immutable || text.Default == nil {
+		t.Error("Text() should be immutable with a default")
+	}
+
+	bin := Binary("id").Descriptor()
+	if bin.Err != nil {
+		t.Fatalf("Binary() error = %v", bin.Err)
+	}
+	if bin.ValueScanner == nil {
+		t.Error("Binary() should use BinaryValueScanner")
+	}
+}
+
+func TestBinaryValueScanner(t *testing.T) {
+	id := ulid.Make()
+	v, err := BinaryValueScanner.Value(id)
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	b, ok := v.([]byte)
+	if !ok || !bytes.Equal(b, id[:]) {
+		t.Fatalf("Value() = %v, want %v", v, id[:])
+	}
+
+	sv := BinaryValueScanner.ScanValue()
+	if err := sv.Scan(b); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	got, err := BinaryValueScanner.FromValue(sv)
+	if err != nil {
+		t.Fatalf("FromValue() error = %v", err)
+	}
+	if got != id {
+		t.Errorf("FromValue() = %v, want %v", got, id)
+	}
+
+	got, err = BinaryValueScanner.FromValue(&sql.Null[[]byte]{})
+	if err != nil || !got.IsZero() {
+		t.Errorf("FromValue(NULL) = %v, %v, want zero", got, err)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/kamalshkeir/ulid/entulid
+
+go 1.25.4
+
+require github.com/kamalshkeir/ulid v1.0.0
+
+require (
+	entgo.io/ent v0.14.6
+	github.com/google/uuid v1.3.0 // indirect
+)
+
+replace github.com/kamalshkeir/ulid => ../
@@ -0,0 +1,100 @@
+package ulid
+
+import (
+	"bufio"
+	"io"
+	"iter"
+)
+
+// extractor is a small state machine finding ULIDs in a byte stream.
+// A ULID is recognized as a run of exactly EncodedSize base32 characters
+// delimited by non base32 characters, so longer alphanumeric tokens that
+// merely contain 26 valid characters are not reported.
+type extractor struct {
+	buf [EncodedSize]byte
+	n   int // length of the current run, may exceed EncodedSize
+}
+
+// feed processes c and returns the ULID ending right before c, if any.
+func (e *extractor) feed(c byte) (ULID, bool) {
+	if dec[c] != 0xFF {
+		if e.n < EncodedSize {
+			e.buf[e.n] = c
+		}
+		e.n++
+		return ULID{}, false
+	}
+	return e.flush()
+}
+
+// flush ends the current run and returns it if it is a valid ULID.
+func (e *extractor) flush() (ULID, bool) {
+	n := e.n
+	e.n = 0
+	if n != EncodedSize {
+		return ULID{}, false
+	}
+	var id ULID
+	return id, decode(&id, e.buf[:], true) == nil
+}
+
+// ExtractAll returns an iterator over the ULIDs found in the text read from
+// r, in order of appearance. Iteration stops at the end of r or on the
+// first read error, which is lost; use ExtractAllErr to tell a truncated
+// input apart from its end.
+func ExtractAll(r io.Reader) iter.Seq[ULID] {
+	return func(yield func(ULID) bool) {
+		for id, err := range ExtractAllErr(r) {
+			if err != nil || !yield(id) {
+				return
+			}
+		}
+	}
+}
+
+// ExtractAllErr is like ExtractAll, but reports a read error as a last
+// pair holding the zero ULID and the error. The end of r is not an error,
+// and a ULID cut by a read error is not reported.
+func ExtractAllErr(r io.Reader) iter.Seq2[ULID, error] {
+	return func(yield func(ULID, error) bool) {
+		br, ok := r.(io.ByteReader)
+		if !ok {
+			br = bufio.NewReader(r)
+		}
+
+		var e extractor
+		for {
+			c, err := br.ReadByte()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				yield(ULID{}, err)
+				return
+			}
+			if id, ok := e.feed(c); ok && !yield(id, nil) {
+				return
+			}
+		}
+		if id, ok := e.flush(); ok {
+			yield(id, nil)
+		}
+	}
+}
+
+// FindAllString returns the ULIDs found in s, in order of appearance.
+func FindAllString(s string) []ULID {
+	var (
+		e   extractor
+		ids []ULID
+	)
+	for i := 0; i < len(s); i++ {
+		if id, ok := e.feed(s[i]); ok {
+			ids = append(ids, id)
+		}
+	}
+	if id, ok := e.flush(); ok {
+		ids = append(ids, id)
+	}
+	return ids
+}
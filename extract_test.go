@@ -0,0 +1,87 @@
+package ulid
+
+import (
+	"io"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestFindAllString(t *testing.T) {
+	a, b := Make(), Make()
+	text := "request " + a.String() + " failed\nretry=" + b.String() +
+		", ignored: 01ARZ3NDEKTSV4RRFFQ69G5FAVX 01ARZ3NDEKTSV4RRFFQ69G5FA " + a.String()
+
+	want := []ULID{a, b, a}
+	if got := FindAllString(text); !slices.Equal(got, want) {
+		t.Errorf("FindAllString() = %v, want %v", got, want)
+	}
+
+	if got := FindAllString(""); len(got) != 0 {
+		t.Errorf("FindAllString(\"\") = %v, want empty", got)
+	}
+}
+
+func TestExtractAll(t *testing.T) {
+	a, b := Make(), Make()
+	text := `{"id":"` + a.String() + `","parent":"` + b.String() + `"}`
+
+	var got []ULID
+	for id := range ExtractAll(strings.NewReader(text)) {
+		got = append(got, id)
+	}
+	if want := []ULID{a, b}; !slices.Equal(got, want) {
+		t.Errorf("ExtractAll() = %v, want %v", got, want)
+	}
+
+	// Early stop
+	n := 0
+	for range ExtractAll(strings.NewReader(text)) {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("ExtractAll() yielded %d ULIDs after break, want 1", n)
+	}
+}
+
+func TestExtractAllErr(t *testing.T) {
+	a, b := Make(), Make()
+
+	// Read error after a complete ULID and in the middle of another one
+	truncated := func() io.Reader {
+		return io.MultiReader(strings.NewReader(a.String()+" "+b.String()[:10]), iotest.ErrReader(io.ErrUnexpectedEOF))
+	}
+
+	var got []ULID
+	var errs []error
+	for id, err := range ExtractAllErr(truncated()) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		got = append(got, id)
+	}
+	if want := []ULID{a}; !slices.Equal(got, want) {
+		t.Errorf("ExtractAllErr() = %v, want %v", got, want)
+	}
+	if len(errs) != 1 || errs[0] != io.ErrUnexpectedEOF {
+		t.Errorf("ExtractAllErr() errors = %v, want [%v]", errs, io.ErrUnexpectedEOF)
+	}
+
+	// ExtractAll stops on the error
+	got = got[:0]
+	for id := range ExtractAll(truncated()) {
+		got = append(got, id)
+	}
+	if want := []ULID{a}; !slices.Equal(got, want) {
+		t.Errorf("ExtractAll() = %v, want %v", got, want)
+	}
+
+	for _, err := range ExtractAllErr(strings.NewReader(a.String())) {
+		if err != nil {
+			t.Errorf("ExtractAllErr() error = %v at the end of the input", err)
+		}
+	}
+}
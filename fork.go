@@ -0,0 +1,124 @@
+package ulid
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// monoStart is the origin of the monotonic readings of forkGuard.
+var monoStart = time.Now()
+
+// forkClockSkew is the divergence between the wall clock and the monotonic
+// clock above which the process is considered restored from a snapshot.
+const forkClockSkew = time.Second
+
+// WithReseedOnForkDetection protects the Generator against VM clones,
+// snapshot restores and process checkpoints, which resume with a copy of
+// the state of the entropy source and of the monotonic state, and would
+// otherwise issue the same ULIDs as the original.
+//
+// Before each generation, the Generator checks whether its PID changed or
+// whether the wall clock jumped away from the monotonic clock. When one of
+// them is detected, a new key is derived from the boot ID, the machine ID,
+// the PID, the current time and fresh bytes from crypto/rand, and mixed
+// into every byte read from the entropy source from then on. The
+// monotonic state is also reset, so the next ULID in the same millisecond
+// may not be greater than the previous one.
+func WithReseedOnForkDetection() Option {
+	return func(g *Generator) {
+		g.fork = &forkGuard{}
+	}
+}
+
+// forkGuard détecte les clones et mélange une nouvelle clé à l'entropie.
+type forkGuard struct {
+	mu      sync.Mutex
+	src     io.Reader
+	pid     int
+	wall    time.Time     // heure murale du dernier contrôle, sans lecture monotone
+	mono    time.Duration // lecture monotone du dernier contrôle
+	mixing  bool
+	key     [32]byte
+	counter uint64
+	stream  []byte // octets restants du bloc de clé courant
+}
+
+func (f *forkGuard) init(src io.Reader) {
+	f.src = src
+	f.pid = os.Getpid()
+	now := time.Now()
+	f.wall, f.mono = now.Round(0), now.Sub(monoStart)
+}
+
+// check returns true if a fork was detected since the last call, in which
+// case the entropy key has been renewed.
+func (f *forkGuard) check() bool {
+	pid, now := os.Getpid(), time.Now()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// L'écart entre le temps écoulé à l'horloge murale et à l'horloge
+	// monotone révèle une horloge murale qui a sauté pendant une pause.
+	wall, mono := now.Round(0), now.Sub(monoStart)
+	skew := wall.Sub(f.wall) - (mono - f.mono)
+	f.wall, f.mono = wall, mono
+
+	if pid == f.pid && skew < forkClockSkew && skew > -forkClockSkew {
+		return false
+	}
+	f.pid = pid
+	f.reseed(now)
+	return true
+}
+
+// reseed must be called with f.mu held.
+func (f *forkGuard) reseed(now time.Time) {
+	h := sha256.New()
+	h.Write(f.key[:])
+	for _, name := range []string{"/proc/sys/kernel/random/boot_id", "/etc/machine-id"} {
+		if b, err := os.ReadFile(name); err == nil {
+			h.Write(b)
+		}
+	}
+	var buf [48]byte
+	binary.BigEndian.PutUint64(buf[0:], uint64(f.pid))
+	binary.BigEndian.PutUint64(buf[8:], uint64(now.UnixNano()))
+	_, _ = rand.Read(buf[16:])
+	h.Write(buf[:])
+
+	h.Sum(f.key[:0])
+	f.counter = 0
+	f.stream = nil
+	f.mixing = true
+}
+
+// Read reads from the entropy source and mixes in the key stream once a
+// fork has been detected.
+func (f *forkGuard) Read(p []byte) (int, error) {
+	n, err := f.src.Read(p)
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if !f.mixing {
+		return n, err
+	}
+	for i := 0; i < n; i++ {
+		if len(f.stream) == 0 {
+			var block [40]byte
+			copy(block[:], f.key[:])
+			binary.BigEndian.PutUint64(block[32:], f.counter)
+			f.counter++
+			sum := sha256.Sum256(block[:])
+			f.stream = sum[:]
+		}
+		p[i] ^= f.stream[0]
+		f.stream = f.stream[1:]
+	}
+	return n, err
+}
@@ -0,0 +1,62 @@
+package ulid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestGeneratorForkDetection(t *testing.T) {
+	now := time.Now()
+	seed := bytes.Repeat([]byte{0x42}, 100)
+
+	// Deux clones avec le même état d'entropie
+	a := NewGenerator(WithMonotonic(), WithEntropy(bytes.NewReader(seed)), WithReseedOnForkDetection())
+	b := NewGenerator(WithMonotonic(), WithEntropy(bytes.NewReader(seed)), WithReseedOnForkDetection())
+
+	ida, _ := a.NewWithTime(now)
+	idb, _ := b.NewWithTime(now)
+	if ida != idb {
+		t.Fatalf("clones without fork should generate the same ULID: %v != %v", ida, idb)
+	}
+
+	// Simulate a restore in each clone: the PID changes
+	a.fork.pid = -1
+	b.fork.pid = -1
+
+	ida, err := a.NewWithTime(now)
+	if err != nil {
+		t.Fatalf("NewWithTime() error = %v", err)
+	}
+	idb, _ = b.NewWithTime(now)
+	if ida == idb {
+		t.Errorf("clones after fork detection generated the same ULID %v", ida)
+	}
+	if a.Metrics().MonotonicIncrements() != 0 {
+		t.Errorf("monotonic state should be reset after fork detection")
+	}
+
+	// Mixing goes on after the detection
+	ida, _ = a.NewWithTime(now.Add(time.Millisecond))
+	idb, _ = b.NewWithTime(now.Add(time.Millisecond))
+	if ida == idb {
+		t.Errorf("clones generated the same ULID %v after reseed", ida)
+	}
+}
+
+func TestForkGuardClockJump(t *testing.T) {
+	g := NewGenerator(WithReseedOnForkDetection())
+	if g.fork.check() {
+		t.Error("check() detected a fork without cause")
+	}
+
+	// Restauration d'un snapshot pris il y a une heure : l'horloge murale a
+	// avancé d'une heure, pas l'horloge monotone
+	g.fork.wall = g.fork.wall.Add(-time.Hour)
+	if !g.fork.check() {
+		t.Error("check() did not detect a wall clock jump")
+	}
+	if g.fork.check() {
+		t.Error("check() detected a fork twice")
+	}
+}
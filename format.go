@@ -0,0 +1,66 @@
+package ulid
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Format returns the ULID rendered according to layout, in which the
+// following directives are replaced:
+//
+//	{id}           the canonical encoding
+//	{entropy}      the entropy as 20 lower case hex digits
+//	{ms}           the timestamp in Unix milliseconds
+//	{time}         the time in UTC, formatted with time.RFC3339Nano
+//	{time:LAYOUT}  the time in UTC, formatted with the Go time layout LAYOUT
+//	{{             a literal {
+//
+// For instance "{time:2006-01-02}/{id}" gives "2016-06-13/01AN4Z07BY79KA1307SR9X4MV3".
+// Unknown directives and any other text are copied as is.
+func (id ULID) Format(layout string) string {
+	var b strings.Builder
+	b.Grow(len(layout) + EncodedSize)
+	for {
+		i := strings.IndexByte(layout, '{')
+		if i < 0 {
+			b.WriteString(layout)
+			return b.String()
+		}
+		b.WriteString(layout[:i])
+		layout = layout[i:]
+
+		if strings.HasPrefix(layout, "{{") {
+			b.WriteByte('{')
+			layout = layout[2:]
+			continue
+		}
+		end := strings.IndexByte(layout, '}')
+		if end < 0 {
+			b.WriteString(layout)
+			return b.String()
+		}
+		directive := layout[1:end]
+		layout = layout[end+1:]
+
+		switch name, arg, hasArg := strings.Cut(directive, ":"); {
+		case directive == "id":
+			a := id.StringArray()
+			b.Write(a[:])
+		case directive == "entropy":
+			var buf [2 * 10]byte
+			hex.Encode(buf[:], id[6:])
+			b.Write(buf[:])
+		case directive == "ms":
+			var buf [20]byte
+			b.Write(strconv.AppendUint(buf[:0], id.Time(), 10))
+		case name == "time" && !hasArg:
+			b.WriteString(id.Timestamp().Format(time.RFC3339Nano))
+		case name == "time":
+			b.WriteString(id.Timestamp().Format(arg))
+		default:
+			b.WriteString("{" + directive + "}")
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	id := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+
+	tests := []struct {
+		layout string
+		want   string
+	}{
+		{"{id}", "01AN4Z07BY79KA1307SR9X4MV3"},
+		{"{time:2006-01-02}/{id}", "2016-06-13/01AN4Z07BY79KA1307SR9X4MV3"},
+		{"{time}", "2016-06-13T13:25:20.894Z"},
+		{"{ms}.{entropy}", "1465824320894.3a66a08c07ce13d25363"},
+		{"id={id} {{literal}", "id=01AN4Z07BY79KA1307SR9X4MV3 {literal}"},
+		{"{unknown} {id", "{unknown} {id"},
+		{"", ""},
+		{"no directive", "no directive"},
+	}
+	for _, tt := range tests {
+		if got := id.Format(tt.layout); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.layout, got, tt.want)
+		}
+	}
+}
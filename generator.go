@@ -0,0 +1,275 @@
+package ulid
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Generator creates ULIDs from a configurable entropy source, optionally
+// guaranteeing monotonic ordering, and keeps counters about its activity.
+//
+// A Generator is safe for concurrent use.
+type Generator struct {
+	mu         sync.Mutex
+	entropy    io.Reader
+	clock      Clock
+	monotonic  bool
+	bump       bool
+	onGenerate func(ULID)
+	kind       uint8
+	kindBits   uint
+	laneBits   uint
+	lanes      *lanes
+	limiter    *limiter
+	window     *window
+	fork       *forkGuard
+	last       ULID
+	metrics    generatorMetrics
+}
+
+// Option configures a Generator.
+type Option func(*Generator)
+
+// WithEntropy sets the entropy source of the Generator, crypto/rand.Reader
+// by default. The source does not need to be safe for concurrent use.
+func WithEntropy(entropy io.Reader) Option {
+	return func(g *Generator) {
+		g.entropy = entropy
+	}
+}
+
+// Clock provides the current time to a Generator.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to the Clock interface.
+type ClockFunc func() time.Time
+
+// Now returns f().
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// WithClock sets the clock used by New and Make, time.Now by default.
+// Frozen or simulated clocks make generated timestamps deterministic.
+func WithClock(c Clock) Option {
+	return func(g *Generator) {
+		g.clock = c
+	}
+}
+
+// WithMonotonic makes the Generator return strictly increasing ULIDs.
+// Within the same millisecond, or if the clock goes backwards, the entropy
+// of the previous ULID is incremented instead of reading new entropy.
+// ErrMonotonicOverflow is returned when the increment overflows, unless
+// WithTimestampBump is set.
+func WithMonotonic() Option {
+	return func(g *Generator) {
+		g.monotonic = true
+	}
+}
+
+// WithTimestampBump makes a monotonic Generator advance the timestamp of
+// the previous ULID by one millisecond and read new entropy when the
+// increment overflows, instead of returning ErrMonotonicOverflow. Bursts
+// then never fail, at the cost of ULIDs slightly ahead of the clock; such
+// bumps are still counted as overflows in the Metrics.
+func WithTimestampBump() Option {
+	return func(g *Generator) {
+		g.bump = true
+	}
+}
+
+// WithOnGenerate registers fn to be called with every ULID generated.
+// fn is called synchronously, outside of the Generator lock.
+func WithOnGenerate(fn func(ULID)) Option {
+	return func(g *Generator) {
+		g.onGenerate = fn
+	}
+}
+
+// NewGenerator returns a Generator configured with the given options.
+func NewGenerator(opts ...Option) *Generator {
+	g := &Generator{entropy: rand.Reader}
+	for _, opt := range opts {
+		opt(g)
+	}
+	if g.entropy == nil {
+		g.entropy = rand.Reader
+	}
+	if g.laneBits > 0 {
+		g.lanes = newLanes(min(g.laneBits, 16-g.kindBits), g.kindBits)
+	}
+	if g.fork != nil {
+		g.fork.init(g.entropy)
+		g.entropy = g.fork
+	}
+	return g
+}
+
+// New returns a new ULID with the current time of the Generator clock.
+func (g *Generator) New() (ULID, error) {
+	return g.NewWithTime(g.now())
+}
+
+func (g *Generator) now() time.Time {
+	if g.clock != nil {
+		return g.clock.Now()
+	}
+	return time.Now()
+}
+
+// NewWithTime returns a new ULID with the given time. ErrNegativeTime is
+// returned for times before the Unix epoch and ErrBigTime for times after
+// MaxTime.
+func (g *Generator) NewWithTime(t time.Time) (ULID, error) {
+	ms, err := timestampOf(t)
+	if err != nil {
+		return ULID{}, err
+	}
+	if g.limiter != nil {
+		if err := g.limiter.take(g.now()); err != nil {
+			return ULID{}, err
+		}
+	}
+	if g.fork != nil && g.fork.check() {
+		g.resetMonotonic()
+	}
+
+	var id ULID
+	if g.lanes != nil {
+		id, err = g.nextLane(ms)
+	} else {
+		g.mu.Lock()
+		id, err = g.next(ms)
+		g.mu.Unlock()
+	}
+	if err != nil {
+		return ULID{}, err
+	}
+	if g.window != nil {
+		if err := g.window.check(id); err != nil {
+			return ULID{}, err
+		}
+	}
+
+	g.metrics.generated.Add(1)
+	if g.onGenerate != nil {
+		g.onGenerate(id)
+	}
+	return id, nil
+}
+
+// Make returns a new ULID with the current time. It panics if the
+// generation fails, see New for a version returning the error.
+func (g *Generator) Make() ULID {
+	id, err := g.New()
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// next must be called with g.mu held.
+func (g *Generator) next(ms uint64) (ULID, error) {
+	if g.monotonic && !g.last.IsZero() && ms <= g.last.Time() {
+		id, ok := incrementEntropy(g.last)
+		if ok && prefixOf(id, g.kindBits) == prefixOf(g.last, g.kindBits) {
+			g.last = id
+			g.metrics.increments.Add(1)
+			return id, nil
+		}
+		g.metrics.overflows.Add(1)
+		if !g.bump || g.last.Time() >= MaxTime {
+			return ULID{}, ErrMonotonicOverflow
+		}
+		ms = g.last.Time() + 1
+	}
+
+	var id ULID
+	_ = id.SetTime(ms)
+	if _, err := io.ReadFull(g.entropy, id[6:]); err != nil {
+		return ULID{}, err
+	}
+	g.metrics.entropyBytes.Add(10)
+	setPrefix(&id, uint16(g.kind), g.kindBits)
+	g.last = id
+	return id, nil
+}
+
+// resetMonotonic forgets the last ULIDs, so the next ones are generated
+// from fresh entropy.
+func (g *Generator) resetMonotonic() {
+	g.mu.Lock()
+	g.last = ULID{}
+	g.mu.Unlock()
+
+	if g.lanes != nil {
+		for i := range g.lanes.all {
+			l := &g.lanes.all[i]
+			l.mu.Lock()
+			l.last = ULID{}
+			l.mu.Unlock()
+		}
+	}
+}
+
+// incrementEntropy returns id with its entropy incremented by one, or false
+// if the entropy overflows.
+func incrementEntropy(id ULID) (ULID, bool) {
+	for i := RawSize - 1; i >= 6; i-- {
+		id[i]++
+		if id[i] != 0 {
+			return id, true
+		}
+	}
+	return id, false
+}
+
+// Metrics exposes the counters of a Generator. It implements expvar.Var,
+// so it can be published with expvar.Publish, and its methods can back
+// Prometheus CounterFunc collectors.
+type Metrics interface {
+	// Generated is the number of ULIDs returned
+	Generated() uint64
+
+	// MonotonicIncrements is the number of ULIDs created by incrementing
+	// the previous entropy
+	MonotonicIncrements() uint64
+
+	// Overflows is the number of monotonic entropy overflows
+	Overflows() uint64
+
+	// EntropyBytes is the number of bytes read from the entropy source
+	EntropyBytes() uint64
+
+	// String returns the counters as a JSON object
+	String() string
+}
+
+// Metrics returns the live counters of the Generator.
+func (g *Generator) Metrics() Metrics {
+	return &g.metrics
+}
+
+type generatorMetrics struct {
+	generated    atomic.Uint64
+	increments   atomic.Uint64
+	overflows    atomic.Uint64
+	entropyBytes atomic.Uint64
+}
+
+func (m *generatorMetrics) Generated() uint64           { return m.generated.Load() }
+func (m *generatorMetrics) MonotonicIncrements() uint64 { return m.increments.Load() }
+func (m *generatorMetrics) Overflows() uint64           { return m.overflows.Load() }
+func (m *generatorMetrics) EntropyBytes() uint64        { return m.entropyBytes.Load() }
+
+func (m *generatorMetrics) String() string {
+	return fmt.Sprintf(`{"generated":%d,"monotonic_increments":%d,"overflows":%d,"entropy_bytes":%d}`,
+		m.Generated(), m.MonotonicIncrements(), m.Overflows(), m.EntropyBytes())
+}
@@ -0,0 +1,144 @@
+package ulid
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestGenerator(t *testing.T) {
+	g := NewGenerator()
+	id, err := g.New()
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	if id.IsZero() {
+		t.Error("New() returned zero ULID")
+	}
+
+	if _, err := g.NewWithTime(Time(MaxTime + 1)); err != ErrBigTime {
+		t.Errorf("NewWithTime(MaxTime+1) error = %v, want %v", err, ErrBigTime)
+	}
+}
+
+func TestGeneratorMonotonic(t *testing.T) {
+	g := NewGenerator(WithMonotonic())
+	now := time.Now()
+
+	prev, err := g.NewWithTime(now)
+	if err != nil {
+		t.Fatalf("NewWithTime() error = %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		id, err := g.NewWithTime(now)
+		if err != nil {
+			t.Fatalf("NewWithTime() error = %v", err)
+		}
+		if !prev.Less(id) {
+			t.Fatalf("ULID %v not greater than %v", id, prev)
+		}
+		prev = id
+	}
+
+	// Clock going backwards keeps the ordering
+	id, err := g.NewWithTime(now.Add(-time.Second))
+	if err != nil {
+		t.Fatalf("NewWithTime() error = %v", err)
+	}
+	if !prev.Less(id) {
+		t.Errorf("ULID %v not greater than %v after clock regression", id, prev)
+	}
+
+	// Overflow
+	g = NewGenerator(WithMonotonic(), WithEntropy(bytes.NewReader(bytes.Repeat([]byte{0xFF}, 10))))
+	if _, err := g.NewWithTime(now); err != nil {
+		t.Fatalf("NewWithTime() error = %v", err)
+	}
+	if _, err := g.NewWithTime(now); err != ErrMonotonicOverflow {
+		t.Errorf("NewWithTime() error = %v, want %v", err, ErrMonotonicOverflow)
+	}
+}
+
+func TestGeneratorTimestampBump(t *testing.T) {
+	now := time.UnixMilli(1469918176385)
+	g := NewGenerator(WithMonotonic(), WithTimestampBump(), WithKind(1, 2),
+		WithEntropy(bytes.NewReader(bytes.Repeat([]byte{0xFF}, 20))))
+	first, err := g.NewWithTime(now)
+	if err != nil {
+		t.Fatalf("NewWithTime() error = %v", err)
+	}
+	id, err := g.NewWithTime(now)
+	if err != nil {
+		t.Fatalf("NewWithTime() error = %v", err)
+	}
+	if id.Time() != first.Time()+1 || !first.Less(id) || id.Kind(2) != 1 {
+		t.Errorf("NewWithTime() = %v after %v", id, first)
+	}
+	if m := g.Metrics(); m.Overflows() != 1 || m.EntropyBytes() != 20 {
+		t.Errorf("Metrics() = %v", m)
+	}
+
+	// Plus de place après MaxTime
+	g = NewGenerator(WithMonotonic(), WithTimestampBump(),
+		WithEntropy(bytes.NewReader(bytes.Repeat([]byte{0xFF}, 10))))
+	if _, err := g.NewWithTime(Time(MaxTime)); err != nil {
+		t.Fatalf("NewWithTime(MaxTime) error = %v", err)
+	}
+	if _, err := g.NewWithTime(Time(MaxTime)); err != ErrMonotonicOverflow {
+		t.Errorf("NewWithTime(MaxTime) error = %v, want %v", err, ErrMonotonicOverflow)
+	}
+}
+
+func TestGeneratorLanesTimestampBump(t *testing.T) {
+	now := time.UnixMilli(1469918176385)
+	g := NewGenerator(WithLanes(2), WithTimestampBump(),
+		WithEntropy(bytes.NewReader(bytes.Repeat([]byte{0xFF}, 1000))))
+	for i := 0; i < 50; i++ {
+		if _, err := g.NewWithTime(now); err != nil {
+			t.Fatalf("NewWithTime() error = %v", err)
+		}
+	}
+	if g.Metrics().Overflows() == 0 {
+		t.Error("Overflows() = 0, want bumps")
+	}
+}
+
+func TestGeneratorHooksAndMetrics(t *testing.T) {
+	var seen []ULID
+	g := NewGenerator(WithMonotonic(), WithOnGenerate(func(id ULID) {
+		seen = append(seen, id)
+	}))
+
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		g.NewWithTime(now)
+	}
+	g.NewWithTime(now.Add(time.Second))
+
+	if len(seen) != 4 {
+		t.Errorf("OnGenerate called %d times, want 4", len(seen))
+	}
+
+	m := g.Metrics()
+	if m.Generated() != 4 || m.MonotonicIncrements() != 2 || m.EntropyBytes() != 20 || m.Overflows() != 0 {
+		t.Errorf("Metrics() = %v", m)
+	}
+
+	var decoded map[string]uint64
+	if err := json.Unmarshal([]byte(m.String()), &decoded); err != nil {
+		t.Fatalf("Metrics().String() is not valid JSON: %v", err)
+	}
+	if decoded["generated"] != 4 {
+		t.Errorf("Metrics().String() = %v", m.String())
+	}
+}
+
+func TestGeneratorClock(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	g := NewGenerator(WithClock(ClockFunc(func() time.Time { return now })))
+
+	if id := g.Make(); id.Time() != Timestamp(now) {
+		t.Errorf("Make().Time() = %v, want %v", Time(id.Time()), now)
+	}
+}
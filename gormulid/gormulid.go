@@ -0,0 +1,79 @@
+// Package gormulid provides GORM serializers storing ulid.ULID fields either
+// as their 26 character string or as 16 raw bytes.
+//
+// Importing the package registers the serializers under the names "ulid"
+// and "ulidbin":
+//
+//	type User struct {
+//		ID     ulid.ULID  `gorm:"primaryKey;type:char(26);serializer:ulid"`
+//		Parent *ulid.ULID `gorm:"type:binary(16);serializer:ulidbin"`
+//	}
+package gormulid
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/kamalshkeir/ulid"
+	"gorm.io/gorm/schema"
+)
+
+func init() {
+	schema.RegisterSerializer("ulid", Serializer{})
+	schema.RegisterSerializer("ulidbin", Serializer{Binary: true})
+}
+
+// Serializer is a schema.SerializerInterface for ulid.ULID and *ulid.ULID
+// fields. Both modes scan either column representation.
+type Serializer struct {
+	// Binary stores ULIDs as 16 raw bytes instead of strings
+	Binary bool
+}
+
+// Scan implements schema.SerializerInterface.
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	rv := field.ReflectValueOf(ctx, dst)
+	if dbValue == nil {
+		rv.Set(reflect.Zero(field.FieldType))
+		return nil
+	}
+
+	var id ulid.ULID
+	if b, ok := dbValue.([]byte); ok && len(b) == ulid.RawSize {
+		copy(id[:], b)
+	} else if err := id.Scan(dbValue); err != nil {
+		return fmt.Errorf("gormulid: field %s: %w", field.Name, err)
+	}
+
+	switch field.FieldType {
+	case reflect.TypeFor[ulid.ULID]():
+		rv.Set(reflect.ValueOf(id))
+	case reflect.TypeFor[*ulid.ULID]():
+		rv.Set(reflect.ValueOf(&id))
+	default:
+		return fmt.Errorf("gormulid: field %s: unsupported type %s", field.Name, field.FieldType)
+	}
+	return nil
+}
+
+// Value implements schema.SerializerValuerInterface.
+func (s Serializer) Value(_ context.Context, field *schema.Field, _ reflect.Value, fieldValue interface{}) (interface{}, error) {
+	var id ulid.ULID
+	switch v := fieldValue.(type) {
+	case ulid.ULID:
+		id = v
+	case *ulid.ULID:
+		if v == nil {
+			return nil, nil
+		}
+		id = *v
+	default:
+		return nil, fmt.Errorf("gormulid: field %s: unsupported type %T", field.Name, fieldValue)
+	}
+
+	if s.Binary {
+		return id.MarshalBinary()
+	}
+	return id.String(), nil
+}
@@ -0,0 +1,74 @@
+package gormulid
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+	"gorm.io/gorm/schema"
+)
+
+type model struct {
+	ID     ulid.ULID  `gorm:"serializer:ulid"`
+	Parent *ulid.ULID `gorm:"serializer:ulidbin"`
+}
+
+func TestSerializer(t *testing.T) {
+	s, err := schema.Parse(&model{}, &sync.Map{}, schema.NamingStrategy{})
+	if err != nil {
+		t.Fatalf("schema.Parse() error = %v", err)
+	}
+	ctx := context.Background()
+	idField, parentField := s.LookUpField("ID"), s.LookUpField("Parent")
+
+	id, parent := ulid.Make(), ulid.Make()
+	var m model
+	dst := reflect.ValueOf(&m)
+
+	// String mode
+	v, err := Serializer{}.Value(ctx, idField, dst, id)
+	if err != nil || v != id.String() {
+		t.Fatalf("Value() = %v, %v, want %v", v, err, id)
+	}
+	if err := (Serializer{}).Scan(ctx, idField, dst, v); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if m.ID != id {
+		t.Errorf("Scan(Value()) = %v, want %v", m.ID, id)
+	}
+
+	// Binary mode with a pointer field
+	v, err = Serializer{Binary: true}.Value(ctx, parentField, dst, &parent)
+	if b, ok := v.([]byte); err != nil || !ok || !bytes.Equal(b, parent[:]) {
+		t.Fatalf("Value(binary) = %v, %v", v, err)
+	}
+	if err := (Serializer{Binary: true}).Scan(ctx, parentField, dst, v); err != nil {
+		t.Fatalf("Scan(binary) error = %v", err)
+	}
+	if m.Parent == nil || *m.Parent != parent {
+		t.Errorf("Scan(Value(binary)) = %v, want %v", m.Parent, parent)
+	}
+
+	// NULL
+	if v, err := (Serializer{}).Value(ctx, parentField, dst, (*ulid.ULID)(nil)); err != nil || v != nil {
+		t.Errorf("Value(nil) = %v, %v, want nil", v, err)
+	}
+	if err := (Serializer{}).Scan(ctx, parentField, dst, nil); err != nil || m.Parent != nil {
+		t.Errorf("Scan(nil) = %v, %v, want nil", m.Parent, err)
+	}
+
+	if err := (Serializer{}).Scan(ctx, idField, dst, "invalid"); err == nil {
+		t.Error("Scan(invalid) should fail")
+	}
+}
+
+func TestRegistered(t *testing.T) {
+	for _, name := range []string{"ulid", "ulidbin"} {
+		if _, ok := schema.GetSerializer(name); !ok {
+			t.Errorf("serializer %q not registered", name)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package ulid
+
+import (
+	"fmt"
+	"io"
+)
+
+// MarshalGQL implements the gqlgen graphql.Marshaler interface, writing the
+// ULID as a quoted string so it can be used as a custom scalar.
+func (id ULID) MarshalGQL(w io.Writer) {
+	var buf [EncodedSize + 2]byte
+	buf[0] = '"'
+	_ = id.MarshalTextTo(buf[1 : EncodedSize+1])
+	buf[EncodedSize+1] = '"'
+	_, _ = w.Write(buf[:])
+}
+
+// UnmarshalGQL implements the gqlgen graphql.Unmarshaler interface. The
+// input must be a string holding a valid ULID; the returned errors are
+// meant to be shown to API clients.
+func (id *ULID) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("ulid: must be a string, got %T", v)
+	}
+	parsed, err := ParseStrict(s)
+	if err != nil {
+		return fmt.Errorf("ulid: invalid ID %q: %w", s, err)
+	}
+	*id = parsed
+	return nil
+}
@@ -0,0 +1,32 @@
+package ulid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMarshalGQL(t *testing.T) {
+	id := Make()
+	var sb strings.Builder
+	id.MarshalGQL(&sb)
+	if sb.String() != `"`+id.String()+`"` {
+		t.Errorf("MarshalGQL() = %v", sb.String())
+	}
+
+	var id2 ULID
+	if err := id2.UnmarshalGQL(id.String()); err != nil {
+		t.Fatalf("UnmarshalGQL() error = %v", err)
+	}
+	if id2 != id {
+		t.Errorf("UnmarshalGQL() = %v, want %v", id2, id)
+	}
+
+	if err := id2.UnmarshalGQL(42); err == nil {
+		t.Error("UnmarshalGQL(int) should fail")
+	}
+	err := id2.UnmarshalGQL("01ARZ3NDEKTSV4RRFFQ69G5F!V")
+	if !errors.Is(err, ErrInvalidCharacters) || !strings.Contains(err.Error(), "01ARZ3NDEKTSV4RRFFQ69G5F!V") {
+		t.Errorf("UnmarshalGQL(invalid) error = %v", err)
+	}
+}
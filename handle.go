@@ -0,0 +1,155 @@
+package ulid
+
+import (
+	"iter"
+	"slices"
+)
+
+// HandleMap is a map of values keyed by interned ULIDs. Its keys are
+// pointer-sized Handles instead of 16 bytes arrays, which makes the map
+// smaller and cheaper to rehash for caches holding millions of entries.
+// The zero value is an empty map ready to use.
+//
+// Methods taking a ULID intern it with Handle; callers already holding
+// Handles should use the *Handle variants to avoid the lookup in the
+// global interning map.
+//
+// A HandleMap is NOT safe for concurrent use.
+type HandleMap[V any] struct {
+	m map[Handle]V
+}
+
+// NewHandleMap returns an empty HandleMap with room for size entries.
+func NewHandleMap[V any](size int) *HandleMap[V] {
+	return &HandleMap[V]{m: make(map[Handle]V, size)}
+}
+
+// Len returns the number of entries in the map.
+func (m *HandleMap[V]) Len() int {
+	return len(m.m)
+}
+
+// Get returns the value stored for id and whether it was present.
+func (m *HandleMap[V]) Get(id ULID) (V, bool) {
+	return m.GetHandle(id.Handle())
+}
+
+// GetHandle returns the value stored for h and whether it was present.
+func (m *HandleMap[V]) GetHandle(h Handle) (V, bool) {
+	v, ok := m.m[h]
+	return v, ok
+}
+
+// Set stores v for id.
+func (m *HandleMap[V]) Set(id ULID, v V) {
+	m.SetHandle(id.Handle(), v)
+}
+
+// SetHandle stores v for h.
+func (m *HandleMap[V]) SetHandle(h Handle, v V) {
+	if m.m == nil {
+		m.m = make(map[Handle]V)
+	}
+	m.m[h] = v
+}
+
+// Delete removes the entry of id. It returns true if id was present.
+func (m *HandleMap[V]) Delete(id ULID) bool {
+	return m.DeleteHandle(id.Handle())
+}
+
+// DeleteHandle removes the entry of h. It returns true if h was present.
+func (m *HandleMap[V]) DeleteHandle(h Handle) bool {
+	if _, ok := m.m[h]; !ok {
+		return false
+	}
+	delete(m.m, h)
+	return true
+}
+
+// All returns an iterator over the entries of the map in ascending ULID
+// order. The iteration works on a snapshot of the keys taken when the
+// iterator starts.
+func (m *HandleMap[V]) All() iter.Seq2[ULID, V] {
+	return func(yield func(ULID, V) bool) {
+		for _, h := range sortedHandles(m.m) {
+			if !yield(h.Value(), m.m[h]) {
+				return
+			}
+		}
+	}
+}
+
+// HandleSet is a Set storing interned ULIDs, see HandleMap. The zero value
+// is an empty set ready to use.
+//
+// A HandleSet is NOT safe for concurrent use.
+type HandleSet struct {
+	m HandleMap[struct{}]
+}
+
+// NewHandleSet returns a HandleSet containing the given ULIDs.
+func NewHandleSet(ids ...ULID) *HandleSet {
+	s := &HandleSet{m: HandleMap[struct{}]{m: make(map[Handle]struct{}, len(ids))}}
+	for _, id := range ids {
+		s.Add(id)
+	}
+	return s
+}
+
+// Len returns the number of ULIDs in the set.
+func (s *HandleSet) Len() int {
+	return s.m.Len()
+}
+
+// Add inserts id into the set. It returns true if id was not already present.
+func (s *HandleSet) Add(id ULID) bool {
+	return s.AddHandle(id.Handle())
+}
+
+// AddHandle inserts h into the set. It returns true if h was not already
+// present.
+func (s *HandleSet) AddHandle(h Handle) bool {
+	if _, ok := s.m.GetHandle(h); ok {
+		return false
+	}
+	s.m.SetHandle(h, struct{}{})
+	return true
+}
+
+// Contains returns true if id is in the set.
+func (s *HandleSet) Contains(id ULID) bool {
+	return s.ContainsHandle(id.Handle())
+}
+
+// ContainsHandle returns true if h is in the set.
+func (s *HandleSet) ContainsHandle(h Handle) bool {
+	_, ok := s.m.GetHandle(h)
+	return ok
+}
+
+// Remove deletes id from the set. It returns true if id was present.
+func (s *HandleSet) Remove(id ULID) bool {
+	return s.m.Delete(id)
+}
+
+// All returns an iterator over the ULIDs of the set in ascending order.
+// The iteration works on a snapshot taken when the iterator starts.
+func (s *HandleSet) All() iter.Seq[ULID] {
+	return func(yield func(ULID) bool) {
+		for _, h := range sortedHandles(s.m.m) {
+			if !yield(h.Value()) {
+				return
+			}
+		}
+	}
+}
+
+func sortedHandles[V any](m map[Handle]V) []Handle {
+	hs := make([]Handle, 0, len(m))
+	for h := range m {
+		hs = append(hs, h)
+	}
+	slices.SortFunc(hs, func(a, b Handle) int { return a.Value().Compare(b.Value()) })
+	return hs
+}
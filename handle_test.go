@@ -0,0 +1,69 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestHandleMap(t *testing.T) {
+	var m HandleMap[string]
+	id1 := MustNew(1, nil)
+	id2 := MustNew(2, nil)
+
+	m.Set(id2, "b")
+	m.SetHandle(id1.Handle(), "a")
+	if m.Len() != 2 {
+		t.Errorf("Len() = %v, want 2", m.Len())
+	}
+	if v, ok := m.Get(id1); !ok || v != "a" {
+		t.Errorf("Get(id1) = %q, %v, want a, true", v, ok)
+	}
+	if v, ok := m.GetHandle(id2.Handle()); !ok || v != "b" {
+		t.Errorf("GetHandle(id2) = %q, %v, want b, true", v, ok)
+	}
+	if _, ok := m.Get(MustNew(3, nil)); ok {
+		t.Error("Get() should return false for missing ULID")
+	}
+
+	var got []ULID
+	for id, v := range m.All() {
+		got = append(got, id)
+		if w, _ := m.Get(id); v != w {
+			t.Errorf("All() value of %v = %q, want %q", id, v, w)
+		}
+	}
+	if len(got) != 2 || got[0] != id1 || got[1] != id2 {
+		t.Errorf("All() = %v, want sorted [%v %v]", got, id1, id2)
+	}
+
+	if !m.Delete(id1) || m.Delete(id1) {
+		t.Error("Delete() returned wrong result")
+	}
+	if m.Len() != 1 {
+		t.Errorf("Len() = %v, want 1", m.Len())
+	}
+}
+
+func TestHandleSet(t *testing.T) {
+	id1 := MustNew(1, nil)
+	id2 := MustNew(2, nil)
+	s := NewHandleSet(id2)
+
+	if !s.Add(id1) || s.AddHandle(id1.Handle()) {
+		t.Error("Add() returned wrong result")
+	}
+	if !s.Contains(id1) || !s.ContainsHandle(id2.Handle()) || s.Contains(MustNew(3, nil)) {
+		t.Error("Contains() returned wrong result")
+	}
+
+	var got []ULID
+	for id := range s.All() {
+		got = append(got, id)
+	}
+	if len(got) != 2 || got[0] != id1 || got[1] != id2 {
+		t.Errorf("All() = %v, want sorted [%v %v]", got, id1, id2)
+	}
+
+	if !s.Remove(id1) || s.Remove(id1) || s.Len() != 1 {
+		t.Error("Remove() returned wrong result")
+	}
+}
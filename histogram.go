@@ -0,0 +1,86 @@
+package ulid
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// Bucket counts the ULIDs created in the time window [Start, Start+width).
+type Bucket struct {
+	Start time.Time
+	Count int
+}
+
+// Histogram describes when the ULIDs of a collection were created.
+type Histogram struct {
+	// Width is the time span of each bucket
+	Width time.Duration
+
+	// Buckets holds the non empty buckets in chronological order
+	Buckets []Bucket
+
+	// Count is the number of ULIDs
+	Count int
+
+	// Min and Max are the oldest and newest timestamps
+	Min, Max time.Time
+
+	// Collisions holds the pairs of distinct ULIDs sharing the same
+	// entropy, a sign of a broken or identically seeded entropy source.
+	// Repeated ULIDs are not collisions, see Audit to find them.
+	Collisions [][2]ULID
+}
+
+// NewHistogram counts the ULIDs of seq in buckets of the given width, in
+// any order. It keeps the entropy of every ULID in memory to find
+// collisions. A width <= 0 uses one bucket per millisecond.
+func NewHistogram(seq iter.Seq[ULID], width time.Duration) Histogram {
+	w := uint64(width.Milliseconds())
+	if w == 0 {
+		w = 1
+	}
+
+	h := Histogram{Width: time.Duration(w) * time.Millisecond}
+	counts := make(map[uint64]int)
+	entropies := make(map[[10]byte]ULID)
+	var lo, hi uint64
+
+	for id := range seq {
+		ms := id.Time()
+		if h.Count == 0 || ms < lo {
+			lo = ms
+		}
+		if h.Count == 0 || ms > hi {
+			hi = ms
+		}
+		h.Count++
+		counts[ms-ms%w]++
+
+		e := id.EntropyArray()
+		if prev, ok := entropies[e]; !ok {
+			entropies[e] = id
+		} else if prev != id {
+			h.Collisions = append(h.Collisions, [2]ULID{prev, id})
+		}
+	}
+
+	if h.Count == 0 {
+		return h
+	}
+	h.Min, h.Max = Time(lo), Time(hi)
+
+	h.Buckets = make([]Bucket, 0, len(counts))
+	for start, n := range counts {
+		h.Buckets = append(h.Buckets, Bucket{Start: Time(start), Count: n})
+	}
+	slices.SortFunc(h.Buckets, func(a, b Bucket) int {
+		return a.Start.Compare(b.Start)
+	})
+	return h
+}
+
+// Span returns the time elapsed between the oldest and the newest ULID.
+func (h Histogram) Span() time.Duration {
+	return h.Max.Sub(h.Min)
+}
@@ -0,0 +1,62 @@
+package ulid
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestHistogram(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ids := []ULID{
+		MakeWithTime(base.Add(65 * time.Second)),
+		MakeWithTime(base),
+		MakeWithTime(base.Add(10 * time.Second)),
+		MakeWithTime(base.Add(3 * time.Minute)),
+	}
+	ids = append(ids, ids[1]) // répétition, pas une collision
+
+	h := NewHistogram(slices.Values(ids), time.Minute)
+
+	if h.Count != 5 || !h.Min.Equal(base) || !h.Max.Equal(base.Add(3*time.Minute)) {
+		t.Errorf("NewHistogram() = count %d min %v max %v", h.Count, h.Min, h.Max)
+	}
+	if h.Span() != 3*time.Minute {
+		t.Errorf("Span() = %v, want 3m", h.Span())
+	}
+
+	want := []Bucket{
+		{base, 3},
+		{base.Add(time.Minute), 1},
+		{base.Add(3 * time.Minute), 1},
+	}
+	if len(h.Buckets) != len(want) {
+		t.Fatalf("Buckets = %v, want %v", h.Buckets, want)
+	}
+	for i := range want {
+		if !h.Buckets[i].Start.Equal(want[i].Start) || h.Buckets[i].Count != want[i].Count {
+			t.Errorf("Buckets[%d] = %v, want %v", i, h.Buckets[i], want[i])
+		}
+	}
+	if len(h.Collisions) != 0 {
+		t.Errorf("Collisions = %v, want none", h.Collisions)
+	}
+}
+
+func TestHistogramCollisions(t *testing.T) {
+	var e [10]byte
+	a, _ := FromParts(1000, e)
+	b, _ := FromParts(2000, e)
+
+	h := NewHistogram(slices.Values([]ULID{a, b, Make()}), 0)
+	if len(h.Collisions) != 1 || h.Collisions[0] != [2]ULID{a, b} {
+		t.Errorf("Collisions = %v, want [[%v %v]]", h.Collisions, a, b)
+	}
+	if h.Width != time.Millisecond {
+		t.Errorf("Width = %v, want 1ms", h.Width)
+	}
+
+	if h := NewHistogram(slices.Values([]ULID(nil)), time.Second); h.Count != 0 || h.Buckets != nil {
+		t.Errorf("NewHistogram(empty) = %+v", h)
+	}
+}
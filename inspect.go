@@ -0,0 +1,76 @@
+package ulid
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// Info is the structured decomposition of a ULID returned by Inspect.
+type Info struct {
+	ID            ULID
+	Time          time.Time
+	Entropy       [10]byte
+	Hex           string
+	UUID          string
+	LeadingZeros  int
+	TrailingZeros int
+}
+
+// Inspect returns the decomposition of id for debugging purposes.
+func (id ULID) Inspect() Info {
+	return Info{
+		ID:            id,
+		Time:          Time(id.Time()).UTC(),
+		Entropy:       [10]byte(id[6:]),
+		Hex:           hex.EncodeToString(id[:]),
+		UUID:          uuidString(id),
+		LeadingZeros:  id.LeadingZeros(),
+		TrailingZeros: id.TrailingZeros(),
+	}
+}
+
+// String returns a human-readable, multi-line representation of the Info.
+func (i Info) String() string {
+	return fmt.Sprintf("ULID:    %s\nTime:    %s\nEntropy: %x\nHex:     %s\nUUID:    %s\nZeros:   %d leading, %d trailing",
+		i.ID, i.Time.Format(time.RFC3339Nano), i.Entropy, i.Hex, i.UUID, i.LeadingZeros, i.TrailingZeros)
+}
+
+// uuidSize is the length of the 8-4-4-4-12 hex form of UUIDs.
+const uuidSize = 36
+
+// uuidString formats id in the 8-4-4-4-12 hex form of UUIDs.
+func uuidString(id ULID) string {
+	var buf [uuidSize]byte
+	return string(appendUUID(buf[:0], id))
+}
+
+// appendUUID appends id to dst in the 8-4-4-4-12 hex form of UUIDs.
+func appendUUID(dst []byte, id ULID) []byte {
+	n := len(dst)
+	dst = append(dst, "00000000-0000-0000-0000-000000000000"...)
+	buf := dst[n:]
+	hex.Encode(buf[0:8], id[0:4])
+	hex.Encode(buf[9:13], id[4:6])
+	hex.Encode(buf[14:18], id[6:8])
+	hex.Encode(buf[19:23], id[8:10])
+	hex.Encode(buf[24:], id[10:])
+	return dst
+}
+
+// parseUUID parses the 8-4-4-4-12 hex form of UUIDs.
+func parseUUID(s []byte) (id ULID, ok bool) {
+	if len(s) != uuidSize || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return id, false
+	}
+	var compact [32]byte
+	n := 0
+	for i, c := range s {
+		if i != 8 && i != 13 && i != 18 && i != 23 {
+			compact[n] = c
+			n++
+		}
+	}
+	_, err := hex.Decode(id[:], compact[:])
+	return id, err == nil
+}
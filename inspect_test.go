@@ -0,0 +1,34 @@
+package ulid
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestInspect(t *testing.T) {
+	id, err := Parse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	info := id.Inspect()
+
+	if info.ID != id {
+		t.Errorf("Inspect().ID = %v, want %v", info.ID, id)
+	}
+	if !info.Time.Equal(Time(id.Time())) || info.Time.Location() != time.UTC {
+		t.Errorf("Inspect().Time = %v", info.Time)
+	}
+	if info.Hex != "01563e3ab5d3d6764c61efb99302bd5b" {
+		t.Errorf("Inspect().Hex = %v", info.Hex)
+	}
+	if info.UUID != "01563e3a-b5d3-d676-4c61-efb99302bd5b" {
+		t.Errorf("Inspect().UUID = %v", info.UUID)
+	}
+	if info.LeadingZeros != id.LeadingZeros() || info.TrailingZeros != id.TrailingZeros() {
+		t.Error("Inspect() zero counts do not match")
+	}
+	if !strings.Contains(info.String(), id.String()) {
+		t.Errorf("Info.String() = %v", info.String())
+	}
+}
@@ -0,0 +1,13 @@
+//go:build !tinygo
+
+package ulid
+
+import "unique"
+
+// Handle est un wrapper pour unique.Handle[ULID], permettant des comparaisons de pointeurs.
+type Handle = unique.Handle[ULID]
+
+// Handle retourne un handle unique pour cet ULID (interning).
+func (id ULID) Handle() Handle {
+	return unique.Make(id)
+}
@@ -0,0 +1,20 @@
+//go:build tinygo
+
+package ulid
+
+// Handle remplace unique.Handle[ULID], que TinyGo ne fournit pas : la
+// valeur est copiée au lieu d'être internée, les comparaisons restent
+// exactes mais coûtent 16 octets au lieu d'un pointeur.
+type Handle struct {
+	id ULID
+}
+
+// Handle retourne un handle pour cet ULID.
+func (id ULID) Handle() Handle {
+	return Handle{id}
+}
+
+// Value returns the ULID of the handle.
+func (h Handle) Value() ULID {
+	return h.id
+}
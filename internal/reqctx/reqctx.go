@@ -0,0 +1,23 @@
+// Package reqctx stores the request ID in a context. It is shared by the
+// HTTP and gRPC integrations, so that an ID assigned by one is seen by the
+// other without either importing the other.
+package reqctx
+
+import (
+	"context"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+type key struct{}
+
+// NewContext returns a copy of ctx carrying the request ID id.
+func NewContext(ctx context.Context, id ulid.ULID) context.Context {
+	return context.WithValue(ctx, key{}, id)
+}
+
+// FromContext returns the request ID stored in ctx, if any.
+func FromContext(ctx context.Context) (ulid.ULID, bool) {
+	id, ok := ctx.Value(key{}).(ulid.ULID)
+	return id, ok
+}
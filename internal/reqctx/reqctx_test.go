@@ -0,0 +1,18 @@
+package reqctx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestContext(t *testing.T) {
+	if _, ok := FromContext(context.Background()); ok {
+		t.Error("FromContext() found a request ID in an empty context")
+	}
+	want := ulid.Make()
+	if got, ok := FromContext(NewContext(context.Background(), want)); !ok || got != want {
+		t.Errorf("FromContext() = %v, %v, want %v, true", got, ok, want)
+	}
+}
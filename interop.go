@@ -0,0 +1,103 @@
+package ulid
+
+import (
+	"encoding/binary"
+	"time"
+)
+
+const snowflakeTimeBits = 41
+
+// FromSnowflake maps a Twitter Snowflake style ID to a ULID. The 41-bit
+// timestamp, counted in milliseconds from epoch, becomes the ULID time and
+// the 22 low machine and sequence bits become the leading entropy bits,
+// the rest of the entropy being zero. The mapping is deterministic and
+// preserves the ordering of the original IDs.
+func FromSnowflake(id int64, epoch time.Time) ULID {
+	ms := uint64(id)>>ShortBits&(1<<snowflakeTimeBits-1) + Timestamp(epoch)
+
+	var res ULID
+	_ = res.SetTime(ms)
+	binary.BigEndian.PutUint32(res[6:], uint32(id)<<(32-ShortBits))
+	return res
+}
+
+// ToSnowflake is the best-effort inverse of FromSnowflake. It is exact for
+// ULIDs created by FromSnowflake with the same epoch; for other ULIDs the
+// trailing 58 entropy bits are lost. ErrBigTime is returned if the ULID
+// time is before epoch or does not fit in 41 bits.
+func (id ULID) ToSnowflake(epoch time.Time) (int64, error) {
+	start := Timestamp(epoch)
+	ms := id.Time()
+	if ms < start || ms-start >= 1<<snowflakeTimeBits {
+		return 0, ErrBigTime
+	}
+	low := binary.BigEndian.Uint32(id[6:]) >> (32 - ShortBits)
+	return int64((ms-start)<<ShortBits | uint64(low)), nil
+}
+
+const (
+	// ksuidEpoch is the KSUID epoch in Unix seconds (2014-05-13T16:53:20Z)
+	ksuidEpoch = 1400000000
+
+	ksuidSize = 20
+	xidSize   = 12
+)
+
+// FromKSUID maps a 20 byte binary KSUID to a ULID. The second precision
+// timestamp is re-based on the Unix epoch and the first 10 payload bytes
+// become the entropy. ErrDataSize is returned if len(b) != 20.
+func FromKSUID(b []byte) (ULID, error) {
+	if len(b) != ksuidSize {
+		return ULID{}, ErrDataSize
+	}
+
+	var id ULID
+	_ = id.SetTime((uint64(binary.BigEndian.Uint32(b)) + ksuidEpoch) * 1000)
+	copy(id[6:], b[4:])
+	return id, nil
+}
+
+// ToKSUID maps the ULID to a 20 byte binary KSUID. The timestamp is
+// truncated to seconds and the payload is the entropy followed by 6 zero
+// bytes. ErrBigTime is returned if the ULID time cannot be represented.
+func (id ULID) ToKSUID() ([]byte, error) {
+	s := id.Time() / 1000
+	if s < ksuidEpoch || s-ksuidEpoch > 1<<32-1 {
+		return nil, ErrBigTime
+	}
+
+	b := make([]byte, ksuidSize)
+	binary.BigEndian.PutUint32(b, uint32(s-ksuidEpoch))
+	copy(b[4:], id[6:])
+	return b, nil
+}
+
+// FromXID maps a 12 byte binary xid to a ULID. The second precision
+// timestamp becomes the ULID time and the 8 machine, pid and counter bytes
+// become the leading entropy bytes, the last 2 being zero. ErrDataSize is
+// returned if len(b) != 12.
+func FromXID(b []byte) (ULID, error) {
+	if len(b) != xidSize {
+		return ULID{}, ErrDataSize
+	}
+
+	var id ULID
+	_ = id.SetTime(uint64(binary.BigEndian.Uint32(b)) * 1000)
+	copy(id[6:], b[4:])
+	return id, nil
+}
+
+// ToXID maps the ULID to a 12 byte binary xid. The timestamp is truncated
+// to seconds and the first 8 entropy bytes are kept. ErrBigTime is returned
+// if the ULID time cannot be represented.
+func (id ULID) ToXID() ([]byte, error) {
+	s := id.Time() / 1000
+	if s > 1<<32-1 {
+		return nil, ErrBigTime
+	}
+
+	b := make([]byte, xidSize)
+	binary.BigEndian.PutUint32(b, uint32(s))
+	copy(b[4:], id[6:14])
+	return b, nil
+}
@@ -0,0 +1,100 @@
+package ulid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+func TestSnowflake(t *testing.T) {
+	// Twitter epoch
+	epoch := time.UnixMilli(1288834974657)
+
+	// 2010-11-04T01:42:54.657Z + 1000ms, worker 5, sequence 7
+	sf := int64(1000)<<22 | 5<<12 | 7
+	id := FromSnowflake(sf, epoch)
+	if id.Time() != 1288834974657+1000 {
+		t.Errorf("FromSnowflake().Time() = %v", id.Time())
+	}
+
+	back, err := id.ToSnowflake(epoch)
+	if err != nil {
+		t.Fatalf("ToSnowflake() error = %v", err)
+	}
+	if back != sf {
+		t.Errorf("ToSnowflake() = %v, want %v", back, sf)
+	}
+
+	// Ordering is preserved within the same millisecond
+	next := FromSnowflake(sf+1, epoch)
+	if !id.Less(next) {
+		t.Error("FromSnowflake() should preserve ordering")
+	}
+
+	if _, err := MustNew(1, nil).ToSnowflake(epoch); err != ErrBigTime {
+		t.Errorf("ToSnowflake(before epoch) error = %v, want %v", err, ErrBigTime)
+	}
+}
+
+func TestKSUID(t *testing.T) {
+	k := make([]byte, 20)
+	binary.BigEndian.PutUint32(k, 100)
+	for i := 4; i < 20; i++ {
+		k[i] = byte(i)
+	}
+
+	id, err := FromKSUID(k)
+	if err != nil {
+		t.Fatalf("FromKSUID() error = %v", err)
+	}
+	if id.Time() != (1400000000+100)*1000 {
+		t.Errorf("FromKSUID().Time() = %v", id.Time())
+	}
+	if !bytes.Equal(id.Entropy(), k[4:14]) {
+		t.Errorf("FromKSUID().Entropy() = %v, want %v", id.Entropy(), k[4:14])
+	}
+
+	back, err := id.ToKSUID()
+	if err != nil {
+		t.Fatalf("ToKSUID() error = %v", err)
+	}
+	if !bytes.Equal(back[:14], k[:14]) || !bytes.Equal(back[14:], make([]byte, 6)) {
+		t.Errorf("ToKSUID() = %v", back)
+	}
+
+	if _, err := FromKSUID(k[1:]); err != ErrDataSize {
+		t.Errorf("FromKSUID(short) error = %v, want %v", err, ErrDataSize)
+	}
+	if _, err := MustNew(1000, nil).ToKSUID(); err != ErrBigTime {
+		t.Errorf("ToKSUID(before epoch) error = %v, want %v", err, ErrBigTime)
+	}
+}
+
+func TestXID(t *testing.T) {
+	x := make([]byte, 12)
+	binary.BigEndian.PutUint32(x, 1700000000)
+	for i := 4; i < 12; i++ {
+		x[i] = byte(i)
+	}
+
+	id, err := FromXID(x)
+	if err != nil {
+		t.Fatalf("FromXID() error = %v", err)
+	}
+	if id.Time() != 1700000000*1000 {
+		t.Errorf("FromXID().Time() = %v", id.Time())
+	}
+
+	back, err := id.ToXID()
+	if err != nil {
+		t.Fatalf("ToXID() error = %v", err)
+	}
+	if !bytes.Equal(back, x) {
+		t.Errorf("ToXID(FromXID()) = %v, want %v", back, x)
+	}
+
+	if _, err := FromXID(x[1:]); err != ErrDataSize {
+		t.Errorf("FromXID(short) error = %v, want %v", err, ErrDataSize)
+	}
+}
@@ -0,0 +1,218 @@
+package ulid
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+)
+
+// ErrJSONArray is returned by UnmarshalJSONArray when the input is not a
+// JSON array.
+var ErrJSONArray = errors.New("ulid: invalid JSON array")
+
+// JSONErrorKind tells why a JSON value could not be unmarshaled as a ULID.
+type JSONErrorKind uint8
+
+const (
+	// JSONNull is a null value where a ULID is required
+	JSONNull JSONErrorKind = iota + 1
+
+	// JSONType is a value which is not a string
+	JSONType
+
+	// JSONLength is a string whose length is not EncodedSize
+	JSONLength
+
+	// JSONCharacters is a string with characters outside of the Crockford
+	// base32 alphabet, or a first character above '7'
+	JSONCharacters
+)
+
+// JSONError is returned when unmarshaling an invalid JSON ULID. It wraps
+// ErrDataSize, ErrInvalidCharacters or ErrOverflow, so callers checking
+// these errors with errors.Is keep working.
+type JSONError struct {
+	Kind JSONErrorKind
+
+	// Length is the length of the JSON string, for JSONLength
+	Length int
+
+	// Offset is the position of the first invalid character in the
+	// string, for JSONCharacters
+	Offset int
+
+	// Err is the underlying parsing error
+	Err error
+}
+
+func (e *JSONError) Error() string {
+	switch e.Kind {
+	case JSONNull:
+		return "ulid: JSON null for a required ULID"
+	case JSONType:
+		return "ulid: JSON value is not a string"
+	case JSONLength:
+		return fmt.Sprintf("ulid: JSON string has length %d, want %d", e.Length, EncodedSize)
+	default:
+		return fmt.Sprintf("ulid: JSON string has an invalid character at offset %d: %v", e.Offset, e.Err)
+	}
+}
+
+func (e *JSONError) Unwrap() error {
+	return e.Err
+}
+
+// jsonShapeError returns the error of a JSON value which is not a quoted
+// string of EncodedSize characters.
+func jsonShapeError(data []byte) error {
+	if string(data) == "null" {
+		return &JSONError{Kind: JSONNull, Err: ErrDataSize}
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return &JSONError{Kind: JSONType, Err: ErrDataSize}
+	}
+	return &JSONError{Kind: JSONLength, Length: len(data) - 2, Err: ErrDataSize}
+}
+
+// jsonCharsError returns the error of the encoded ULID v which failed to
+// parse with err.
+func jsonCharsError(v []byte, err error) error {
+	e := &JSONError{Kind: JSONCharacters, Err: err}
+	for i, c := range v {
+		if dec[c] == 0xFF {
+			e.Offset = i
+			break
+		}
+	}
+	return e
+}
+
+// Required wraps a ULID whose JSON unmarshaling rejects null with a
+// JSONError of kind JSONNull, for fields that must hold an ID. As for any
+// type, a field missing from the JSON object is left untouched.
+type Required struct {
+	ULID
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (r *Required) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return jsonShapeError(data)
+	}
+	return r.ULID.UnmarshalJSON(data)
+}
+
+// UnmarshalJSONArray decodes a JSON array of encoded ULIDs in a single pass,
+// without the reflection of encoding/json. A JSON null decodes to a nil
+// slice. Invalid elements, null included, are reported as a *ListError
+// wrapping a *JSONError; a malformed array returns an error wrapping
+// ErrJSONArray.
+func UnmarshalJSONArray(data []byte) ([]ULID, error) {
+	data = skipJSONSpace(data)
+	if bytes.Equal(bytes.TrimRight(data, jsonSpace), []byte("null")) {
+		return nil, nil
+	}
+	if len(data) == 0 || data[0] != '[' {
+		return nil, fmt.Errorf("%w: missing [", ErrJSONArray)
+	}
+	rest := skipJSONSpace(data[1:])
+
+	// Chaque élément occupe au moins 29 octets avec la virgule
+	ids := make([]ULID, 0, len(rest)/(EncodedSize+3)+1)
+	if len(rest) > 0 && rest[0] == ']' {
+		rest = rest[1:]
+	} else {
+		for i := 0; ; i++ {
+			if len(rest) == 0 {
+				return nil, fmt.Errorf("%w: missing ]", ErrJSONArray)
+			}
+			end := jsonValueEnd(rest)
+			elem := rest[:end]
+			var id ULID
+			err := id.UnmarshalJSON(elem)
+			if err == nil && string(elem) == "null" {
+				err = jsonShapeError(elem)
+			}
+			if err != nil {
+				line := bytes.Count(data[:len(data)-len(rest)], []byte{'\n'}) + 1
+				return nil, &ListError{Index: i, Line: line, Err: err}
+			}
+			ids = append(ids, id)
+
+			rest = skipJSONSpace(rest[end:])
+			if len(rest) == 0 {
+				return nil, fmt.Errorf("%w: missing ]", ErrJSONArray)
+			}
+			c := rest[0]
+			rest = skipJSONSpace(rest[1:])
+			if c == ']' {
+				break
+			}
+			if c != ',' || len(rest) == 0 || rest[0] == ']' {
+				return nil, fmt.Errorf("%w: unexpected %q after element %d", ErrJSONArray, c, i)
+			}
+		}
+	}
+	if len(skipJSONSpace(rest)) != 0 {
+		return nil, fmt.Errorf("%w: data after ]", ErrJSONArray)
+	}
+	return ids, nil
+}
+
+const jsonSpace = " \t\r\n"
+
+func skipJSONSpace(data []byte) []byte {
+	for len(data) > 0 && (data[0] == ' ' || data[0] == '\t' || data[0] == '\r' || data[0] == '\n') {
+		data = data[1:]
+	}
+	return data
+}
+
+// jsonValueEnd returns the length of the JSON value at the start of data,
+// as far as needed to report an error about it: strings end at their
+// closing quote and other values at the next separator.
+func jsonValueEnd(data []byte) int {
+	if len(data) > 0 && data[0] == '"' {
+		if i := bytes.IndexByte(data[1:], '"'); i >= 0 {
+			return i + 2
+		}
+		return len(data)
+	}
+	if i := bytes.IndexAny(data, ",]"+jsonSpace); i > 0 {
+		return i
+	}
+	if len(data) > 0 && (data[0] == ',' || data[0] == ']') {
+		return 1
+	}
+	return len(data)
+}
+
+// MarshalJSON implements the json.Marshaler interface, returning a JSON
+// array of encoded ULIDs, or null for a nil slice.
+func (ids ULIDs) MarshalJSON() ([]byte, error) {
+	if ids == nil {
+		return []byte("null"), nil
+	}
+	b := make([]byte, 1, 2+len(ids)*(EncodedSize+3))
+	b[0] = '['
+	for i, id := range ids {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		n := len(b)
+		b = append(b, `"00000000000000000000000000"`...)
+		_ = id.MarshalTextTo(b[n+1 : n+1+EncodedSize])
+	}
+	return append(b, ']'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, see
+// UnmarshalJSONArray.
+func (ids *ULIDs) UnmarshalJSON(data []byte) error {
+	res, err := UnmarshalJSONArray(data)
+	if err != nil {
+		return err
+	}
+	*ids = res
+	return nil
+}
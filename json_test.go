@@ -0,0 +1,165 @@
+package ulid
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	tests := []struct {
+		data   string
+		kind   JSONErrorKind
+		err    error
+		length int
+		offset int
+	}{
+		{`123`, JSONType, ErrDataSize, 0, 0},
+		{`{"id":"01AN4Z07BY79KA1307SR9X4MV3"}`, JSONType, ErrDataSize, 0, 0},
+		{`true`, JSONType, ErrDataSize, 0, 0},
+		{`"01AN4Z07BY79KA1307SR9X4MV"`, JSONLength, ErrDataSize, 25, 0},
+		{`""`, JSONLength, ErrDataSize, 0, 0},
+		{`"01AN4Z07BY79KA1307SR9X4MVU"`, JSONCharacters, ErrInvalidCharacters, 0, 25},
+		{`"01AN4Z07BY79KA13-7SR9X4MV3"`, JSONCharacters, ErrInvalidCharacters, 0, 16},
+		{`"8ZZZZZZZZZZZZZZZZZZZZZZZZZ"`, JSONCharacters, ErrOverflow, 0, 0},
+	}
+	for _, tt := range tests {
+		var id ULID
+		err := id.UnmarshalJSON([]byte(tt.data))
+
+		var je *JSONError
+		if !errors.As(err, &je) {
+			t.Errorf("UnmarshalJSON(%s) error = %v, want *JSONError", tt.data, err)
+			continue
+		}
+		if je.Kind != tt.kind || je.Length != tt.length || je.Offset != tt.offset || !errors.Is(err, tt.err) {
+			t.Errorf("UnmarshalJSON(%s) error = %+v, want kind %d length %d offset %d %v",
+				tt.data, je, tt.kind, tt.length, tt.offset, tt.err)
+		}
+		if je.Error() == "" {
+			t.Errorf("UnmarshalJSON(%s) error has no message", tt.data)
+		}
+	}
+
+	id := Make()
+	data, _ := id.MarshalJSON()
+	var got ULID
+	if n := testing.AllocsPerRun(100, func() { _ = got.UnmarshalJSON(data) }); n != 0 {
+		t.Errorf("UnmarshalJSON() allocs = %v, want 0", n)
+	}
+}
+
+func TestRequired(t *testing.T) {
+	var v struct {
+		ID Required `json:"id"`
+	}
+
+	id := Make()
+	if err := json.Unmarshal([]byte(`{"id":"`+id.String()+`"}`), &v); err != nil || v.ID.ULID != id {
+		t.Errorf("json.Unmarshal() = %v, %v, want %v", v.ID, err, id)
+	}
+
+	err := json.Unmarshal([]byte(`{"id":null}`), &v)
+	var je *JSONError
+	if !errors.As(err, &je) || je.Kind != JSONNull {
+		t.Errorf("json.Unmarshal(null) error = %v, want JSONNull", err)
+	}
+}
+
+func TestUnmarshalJSONArray(t *testing.T) {
+	a := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	b := MustParse("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	tests := []struct {
+		in   string
+		want []ULID
+	}{
+		{`["01AN4Z07BY79KA1307SR9X4MV3","01ARZ3NDEKTSV4RRFFQ69G5FAV"]`, []ULID{a, b}},
+		{" [\n  \"01AN4Z07BY79KA1307SR9X4MV3\" ,\n\t\"01arz3ndektsv4rrffq69g5fav\"\n]\n", []ULID{a, b}},
+		{`[]`, []ULID{}},
+		{` [ ] `, []ULID{}},
+		{`null`, nil},
+	}
+	for _, tt := range tests {
+		got, err := UnmarshalJSONArray([]byte(tt.in))
+		if err != nil {
+			t.Errorf("UnmarshalJSONArray(%q) error = %v", tt.in, err)
+			continue
+		}
+		if (got == nil) != (tt.want == nil) || !slices.Equal(got, tt.want) {
+			t.Errorf("UnmarshalJSONArray(%q) = %#v, want %#v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestUnmarshalJSONArrayErrors(t *testing.T) {
+	for _, in := range []string{``, `{}`, `[`, `["01AN4Z07BY79KA1307SR9X4MV3"`, `["01AN4Z07BY79KA1307SR9X4MV3",]`,
+		`["01AN4Z07BY79KA1307SR9X4MV3" "01ARZ3NDEKTSV4RRFFQ69G5FAV"]`, `[,]`, `[] []`} {
+		if _, err := UnmarshalJSONArray([]byte(in)); !errors.Is(err, ErrJSONArray) {
+			var le *ListError
+			if !errors.As(err, &le) {
+				t.Errorf("UnmarshalJSONArray(%q) error = %v, want %v", in, err, ErrJSONArray)
+			}
+		}
+	}
+
+	tests := []struct {
+		in    string
+		index int
+		line  int
+		kind  JSONErrorKind
+	}{
+		{`["01AN4Z07BY79KA1307SR9X4MV3",null]`, 1, 1, JSONNull},
+		{"[\"01AN4Z07BY79KA1307SR9X4MV3\",\n42]", 1, 2, JSONType},
+		{`["01AN4Z07BY79KA1307SR9X4MV"]`, 0, 1, JSONLength},
+		{`["01AN4Z07BY79KA1307SR9X4MV3","01AN4Z07BY79KA1307SR9X4M!3"]`, 1, 1, JSONCharacters},
+	}
+	for _, tt := range tests {
+		_, err := UnmarshalJSONArray([]byte(tt.in))
+		var le *ListError
+		var je *JSONError
+		if !errors.As(err, &le) || !errors.As(err, &je) {
+			t.Errorf("UnmarshalJSONArray(%q) error = %v, want *ListError", tt.in, err)
+			continue
+		}
+		if le.Index != tt.index || le.Line != tt.line || je.Kind != tt.kind {
+			t.Errorf("UnmarshalJSONArray(%q) error = %v, want index %d line %d kind %d", tt.in, err, tt.index, tt.line, tt.kind)
+		}
+	}
+}
+
+func TestULIDsJSON(t *testing.T) {
+	ids := ULIDs{Make(), Make()}
+	data, err := json.Marshal(struct{ IDs ULIDs }{ids})
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	want, _ := json.Marshal(struct{ IDs []string }{EncodeAll(ids)})
+	if string(data) != string(want) {
+		t.Errorf("Marshal() = %s, want %s", data, want)
+	}
+
+	var got struct{ IDs ULIDs }
+	if err := json.Unmarshal(data, &got); err != nil || !slices.Equal(got.IDs, ids) {
+		t.Errorf("Unmarshal(%s) = %v, %v, want %v", data, got.IDs, err, ids)
+	}
+
+	if data, _ := json.Marshal(ULIDs(nil)); string(data) != "null" {
+		t.Errorf("Marshal(nil) = %s, want null", data)
+	}
+}
+
+func BenchmarkUnmarshalJSONArray(b *testing.B) {
+	ids := make(ULIDs, 1000)
+	for i := range ids {
+		ids[i] = Make()
+	}
+	data, _ := ids.MarshalJSON()
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = UnmarshalJSONArray(data)
+	}
+}
@@ -0,0 +1,43 @@
+package ulid
+
+import (
+	"encoding/binary"
+)
+
+// WithKind reserves the top bits of the entropy of the generated ULIDs for
+// the application-defined tag k, e.g. a record type, so heterogeneous
+// tables can tell records apart from their ID alone. bits is clamped to
+// [1, 8] and k is truncated to bits. Read the tag back with ULID.Kind.
+//
+// Each reserved bit halves the number of ULIDs that can be created in the
+// same millisecond without collision.
+func WithKind(k uint8, bits int) Option {
+	return func(g *Generator) {
+		g.kindBits = uint(max(1, min(bits, 8)))
+		g.kind = k & (1<<g.kindBits - 1)
+	}
+}
+
+// Kind returns the tag stored in the top bits of the entropy of id by a
+// Generator created with WithKind. bits is clamped to [1, 8].
+func (id ULID) Kind(bits int) uint8 {
+	return id[6] >> (8 - max(1, min(bits, 8)))
+}
+
+// setPrefix stores v in the top n bits of the entropy of id, n <= 16.
+func setPrefix(id *ULID, v uint16, n uint) {
+	if n == 0 {
+		return
+	}
+	shift := 16 - n
+	hi := binary.BigEndian.Uint16(id[6:8])
+	binary.BigEndian.PutUint16(id[6:8], hi&(1<<shift-1)|v<<shift)
+}
+
+// prefixOf returns the top n bits of the entropy of id, n <= 16.
+func prefixOf(id ULID, n uint) uint16 {
+	if n == 0 {
+		return 0
+	}
+	return binary.BigEndian.Uint16(id[6:8]) >> (16 - n)
+}
@@ -0,0 +1,60 @@
+package ulid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestGeneratorKind(t *testing.T) {
+	const order, invoice = 1, 5
+
+	orders := NewGenerator(WithKind(order, 3))
+	invoices := NewGenerator(WithKind(invoice, 3), WithMonotonic())
+	for i := 0; i < 100; i++ {
+		if k := orders.Make().Kind(3); k != order {
+			t.Fatalf("Kind(3) = %d, want %d", k, order)
+		}
+		if k := invoices.Make().Kind(3); k != invoice {
+			t.Fatalf("Kind(3) = %d, want %d", k, invoice)
+		}
+	}
+
+	// k is truncated to bits
+	if k := NewGenerator(WithKind(0xFF, 2)).Make().Kind(2); k != 3 {
+		t.Errorf("Kind(2) = %d, want 3", k)
+	}
+	if k := NewGenerator(WithKind(0xAB, 8)).Make().Kind(8); k != 0xAB {
+		t.Errorf("Kind(8) = %#x, want 0xab", k)
+	}
+}
+
+func TestGeneratorKindOverflow(t *testing.T) {
+	// Entropy at the maximum below the kind bits: the next increment would
+	// change the kind
+	now := time.Now()
+	g := NewGenerator(WithKind(0, 1), WithMonotonic(), WithEntropy(bytes.NewReader(bytes.Repeat([]byte{0xFF}, 10))))
+	id, err := g.NewWithTime(now)
+	if err != nil || id.Kind(1) != 0 {
+		t.Fatalf("NewWithTime() = %v, %v", id, err)
+	}
+	if _, err := g.NewWithTime(now); err != ErrMonotonicOverflow {
+		t.Errorf("NewWithTime() error = %v, want %v", err, ErrMonotonicOverflow)
+	}
+}
+
+func TestGeneratorKindLanes(t *testing.T) {
+	g := NewGenerator(WithLanes(16), WithKind(6, 4))
+	if g.lanes.bits != 12 {
+		t.Errorf("lane bits = %d, want 12", g.lanes.bits)
+	}
+	for i := 0; i < 100; i++ {
+		id := g.Make()
+		if k := id.Kind(4); k != 6 {
+			t.Fatalf("Kind(4) = %d, want 6", k)
+		}
+		if l := g.lanes.laneOf(id); int(l) >= len(g.lanes.all) {
+			t.Fatalf("lane %d out of range", l)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package ulid
+
+import (
+	"time"
+)
+
+// AppendKey appends id in binary form to prefix, returning the extended
+// key. Keys built from the same prefix sort in the same order as their
+// ULIDs, which makes them usable as time-ordered keys in bbolt, Badger,
+// Pebble and other ordered KV stores.
+func AppendKey(prefix []byte, id ULID) []byte {
+	key := make([]byte, 0, len(prefix)+RawSize)
+	key = append(key, prefix...)
+	return append(key, id[:]...)
+}
+
+// KeyULID returns the ULID stored at the end of a key built by AppendKey.
+// ErrDataSize is returned if the key is shorter than RawSize.
+func KeyULID(key []byte) (ULID, error) {
+	var id ULID
+	if len(key) < RawSize {
+		return id, ErrDataSize
+	}
+	copy(id[:], key[len(key)-RawSize:])
+	return id, nil
+}
+
+// PrefixRange returns the [start, end) key range covering the keys built by
+// AppendKey with prefix for ULIDs created from the millisecond of from up
+// to, but excluding, the millisecond of to. Times are clamped to the range
+// representable by ULIDs.
+func PrefixRange(prefix []byte, from, to time.Time) (start, end []byte) {
+	return AppendKey(prefix, FirstOfMillisecond(from)), AppendKey(prefix, FirstOfMillisecond(to))
+}
+
+// clampTimestamp returns the Unix milliseconds of t within [0, MaxTime].
+func clampTimestamp(t time.Time) uint64 {
+	return min(Timestamp(t), MaxTime)
+}
@@ -0,0 +1,60 @@
+package ulid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestAppendKey(t *testing.T) {
+	prefix := []byte("events/")
+	a := MustNew(1000, nil)
+	b := MustNew(2000, nil)
+
+	ka, kb := AppendKey(prefix, a), AppendKey(prefix, b)
+	if !bytes.HasPrefix(ka, prefix) || len(ka) != len(prefix)+RawSize {
+		t.Errorf("AppendKey() = %x", ka)
+	}
+	if bytes.Compare(ka, kb) >= 0 {
+		t.Error("AppendKey() should preserve ordering")
+	}
+
+	got, err := KeyULID(ka)
+	if err != nil || got != a {
+		t.Errorf("KeyULID() = %v, %v, want %v", got, err, a)
+	}
+	if _, err := KeyULID(prefix); err != ErrDataSize {
+		t.Errorf("KeyULID(short) error = %v, want %v", err, ErrDataSize)
+	}
+
+	// The caller's prefix must not be aliased
+	p := make([]byte, 3, 64)
+	k1, k2 := AppendKey(p, a), AppendKey(p, b)
+	if bytes.Equal(k1, k2) {
+		t.Error("AppendKey() should not share the prefix backing array")
+	}
+}
+
+func TestPrefixRange(t *testing.T) {
+	prefix := []byte("events/")
+	base := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	from, to := base, base.Add(time.Hour)
+
+	start, end := PrefixRange(prefix, from, to)
+
+	in := []ULID{MakeWithTime(from), MakeWithTime(to.Add(-time.Millisecond))}
+	out := []ULID{MakeWithTime(from.Add(-time.Millisecond)), MakeWithTime(to)}
+
+	for _, id := range in {
+		k := AppendKey(prefix, id)
+		if bytes.Compare(k, start) < 0 || bytes.Compare(k, end) >= 0 {
+			t.Errorf("key of %v should be in range", Time(id.Time()))
+		}
+	}
+	for _, id := range out {
+		k := AppendKey(prefix, id)
+		if bytes.Compare(k, start) >= 0 && bytes.Compare(k, end) < 0 {
+			t.Errorf("key of %v should not be in range", Time(id.Time()))
+		}
+	}
+}
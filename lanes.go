@@ -0,0 +1,105 @@
+package ulid
+
+import (
+	"crypto/rand"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// WithLanes makes the Generator monotonic per lane instead of globally, for
+// extremely hot paths where the lock of WithMonotonic is contended.
+//
+// The Generator has 2^bits lanes, bits being clamped to [1, 16], and the
+// top bits of the entropy of each ULID hold the ID of its lane, after the
+// kind bits of WithKind if any. The lane bits are reduced if needed so that
+// both fit in 16 bits. Lanes are
+// handed out through a sync.Pool, so each P (see runtime.GOMAXPROCS)
+// mostly reuses its own lane without synchronizing with the others. ULIDs
+// are strictly increasing within a lane, but not across lanes in the same
+// millisecond.
+//
+// With a custom entropy source, reads from it are still serialized by the
+// Generator lock.
+func WithLanes(bits int) Option {
+	return func(g *Generator) {
+		g.laneBits = uint(max(1, min(bits, 16)))
+	}
+}
+
+// lane est l'état monotone d'une voie, aligné pour éviter le false sharing.
+type lane struct {
+	mu   sync.Mutex
+	id   uint16
+	last ULID
+	_    [32]byte
+}
+
+type lanes struct {
+	bits uint // bits des IDs de voie
+	skip uint // bits réservés avant ceux des voies
+	all  []lane
+	next atomic.Uint32
+	pool sync.Pool
+}
+
+func newLanes(bits, skip uint) *lanes {
+	ls := &lanes{bits: bits, skip: skip, all: make([]lane, 1<<bits)}
+	for i := range ls.all {
+		ls.all[i].id = uint16(i)
+	}
+	ls.pool.New = func() any {
+		// Si le pool est vidé par le GC, les voies sont réattribuées en
+		// tourniquet et peuvent être partagées, d'où le mutex par voie.
+		n := ls.next.Add(1) - 1
+		return &ls.all[n%uint32(len(ls.all))]
+	}
+	return ls
+}
+
+// laneOf returns the lane ID stored in id.
+func (ls *lanes) laneOf(id ULID) uint16 {
+	return prefixOf(id, ls.skip+ls.bits) & (1<<ls.bits - 1)
+}
+
+func (g *Generator) nextLane(ms uint64) (ULID, error) {
+	l := g.lanes.pool.Get().(*lane)
+	defer g.lanes.pool.Put(l)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.last.IsZero() && ms <= l.last.Time() {
+		n := g.lanes.skip + g.lanes.bits
+		id, ok := incrementEntropy(l.last)
+		if ok && prefixOf(id, n) == prefixOf(l.last, n) {
+			l.last = id
+			g.metrics.increments.Add(1)
+			return id, nil
+		}
+		g.metrics.overflows.Add(1)
+		if !g.bump || l.last.Time() >= MaxTime {
+			return ULID{}, ErrMonotonicOverflow
+		}
+		ms = l.last.Time() + 1
+	}
+
+	var id ULID
+	_ = id.SetTime(ms)
+	if g.entropy == rand.Reader {
+		if _, err := rand.Read(id[6:]); err != nil {
+			return ULID{}, err
+		}
+	} else {
+		g.mu.Lock()
+		_, err := io.ReadFull(g.entropy, id[6:])
+		g.mu.Unlock()
+		if err != nil {
+			return ULID{}, err
+		}
+	}
+	g.metrics.entropyBytes.Add(10)
+	setPrefix(&id, uint16(g.kind)<<g.lanes.bits|l.id, g.lanes.skip+g.lanes.bits)
+	l.last = id
+	return id, nil
+}
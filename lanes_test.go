@@ -0,0 +1,100 @@
+package ulid
+
+import (
+	"bytes"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGeneratorLanes(t *testing.T) {
+	g := NewGenerator(WithLanes(4))
+	now := time.Now()
+
+	const workers, n = 8, 2000
+	var mu sync.Mutex
+	perLane := make(map[uint16][]ULID)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < n; i++ {
+				id, err := g.NewWithTime(now)
+				if err != nil {
+					t.Errorf("NewWithTime() error = %v", err)
+					return
+				}
+				mu.Lock()
+				l := g.lanes.laneOf(id)
+				perLane[l] = append(perLane[l], id)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	seen := make(map[ULID]bool)
+	for l, ids := range perLane {
+		if l >= 16 {
+			t.Errorf("lane %d out of range", l)
+		}
+		for _, id := range ids {
+			if seen[id] {
+				t.Fatalf("duplicate ULID %v", id)
+			}
+			seen[id] = true
+		}
+	}
+	if len(seen) != workers*n {
+		t.Errorf("generated %d ULIDs, want %d", len(seen), workers*n)
+	}
+}
+
+func TestGeneratorLanesMonotonic(t *testing.T) {
+	g := NewGenerator(WithLanes(1))
+	now := time.Now()
+
+	// Sans concurrence, le pool rend toujours la même voie
+	prev, _ := g.NewWithTime(now)
+	for i := 0; i < 100; i++ {
+		id, err := g.NewWithTime(now)
+		if err != nil {
+			t.Fatalf("NewWithTime() error = %v", err)
+		}
+		if g.lanes.laneOf(id) == g.lanes.laneOf(prev) && !prev.Less(id) {
+			t.Fatalf("ULID %v not greater than %v", id, prev)
+		}
+		prev = id
+	}
+
+	// An increment carrying into the lane bits is an overflow
+	// (le pool peut changer de voie, notamment avec -race)
+	g = NewGenerator(WithLanes(16), WithEntropy(bytes.NewReader(bytes.Repeat([]byte{0xFF}, 1000))))
+	overflow := false
+	for i := 0; i < 50 && !overflow; i++ {
+		_, err := g.NewWithTime(now)
+		overflow = err == ErrMonotonicOverflow
+	}
+	if !overflow {
+		t.Errorf("NewWithTime() never returned %v", ErrMonotonicOverflow)
+	}
+}
+
+func BenchmarkGeneratorLanes(b *testing.B) {
+	g := NewGenerator(WithLanes(8))
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			g.New()
+		}
+	})
+}
+
+func BenchmarkGeneratorMonotonicParallel(b *testing.B) {
+	g := NewGenerator(WithMonotonic())
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			g.New()
+		}
+	})
+}
@@ -0,0 +1,43 @@
+package ulid
+
+// EntropyFirst is an alternate 16 byte layout of a ULID where the 10 entropy
+// bytes come first and the 6 timestamp bytes last. Keys using this layout
+// are spread uniformly across the keyspace, which avoids the hot partitions
+// that timestamp-ordered keys cause in write-heavy KV stores. Converting
+// back with ULID restores the original, sortable ID.
+type EntropyFirst [RawSize]byte
+
+// EntropyFirst returns id in the entropy-first layout.
+func (id ULID) EntropyFirst() EntropyFirst {
+	var e EntropyFirst
+	copy(e[:10], id[6:])
+	copy(e[10:], id[:6])
+	return e
+}
+
+// ULID converts e back to the standard, time-ordered layout.
+func (e EntropyFirst) ULID() ULID {
+	var id ULID
+	copy(id[:6], e[10:])
+	copy(id[6:], e[:10])
+	return id
+}
+
+// Bytes returns e as a byte slice.
+func (e EntropyFirst) Bytes() []byte {
+	return e[:]
+}
+
+// String returns the Crockford base32 encoding of the entropy-first bytes.
+func (e EntropyFirst) String() string {
+	var buf [EncodedSize]byte
+	_ = ULID(e).MarshalTextTo(buf[:])
+	return string(buf[:])
+}
+
+// ParseEntropyFirst parses the string encoding of an entropy-first ULID as
+// returned by EntropyFirst.String.
+func ParseEntropyFirst(s string) (EntropyFirst, error) {
+	id, err := ParseStrict(s)
+	return EntropyFirst(id), err
+}
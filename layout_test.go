@@ -0,0 +1,28 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestEntropyFirst(t *testing.T) {
+	id := Make()
+	e := id.EntropyFirst()
+
+	if got := e.ULID(); got != id {
+		t.Errorf("EntropyFirst().ULID() = %v, want %v", got, id)
+	}
+
+	var want [10]byte
+	copy(want[:], id.Entropy())
+	if string(e.Bytes()[:10]) != string(want[:]) {
+		t.Error("EntropyFirst() should start with the entropy bytes")
+	}
+
+	parsed, err := ParseEntropyFirst(e.String())
+	if err != nil {
+		t.Fatalf("ParseEntropyFirst() error = %v", err)
+	}
+	if parsed != e {
+		t.Errorf("ParseEntropyFirst(String()) = %v, want %v", parsed, e)
+	}
+}
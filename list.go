@@ -0,0 +1,138 @@
+package ulid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ListError is returned by UnmarshalList when an entry of the list is not a
+// valid ULID.
+type ListError struct {
+	// Index is the position of the invalid entry in the list, from 0
+	Index int
+
+	// Line is the line of the invalid entry, from 1
+	Line int
+
+	// Err is the parsing error
+	Err error
+}
+
+func (e *ListError) Error() string {
+	return fmt.Sprintf("ulid: list entry %d (line %d): %v", e.Index, e.Line, e.Err)
+}
+
+func (e *ListError) Unwrap() error {
+	return e.Err
+}
+
+// AppendList appends the text encoding of ids to dst, separated by sep,
+// typically '\n' or ','. A newline separated list ends with a newline.
+func AppendList(dst []byte, ids []ULID, sep byte) []byte {
+	dst = AppendAllText(dst, ids, sep)
+	if sep == '\n' && len(ids) > 0 {
+		dst = append(dst, '\n')
+	}
+	return dst
+}
+
+// AppendAllText appends the text encoding of ids to dst, separated by sep.
+// Unlike AppendList, nothing follows the last ULID. The needed capacity is
+// reserved up front, so dst grows at most once.
+func AppendAllText(dst []byte, ids []ULID, sep byte) []byte {
+	dst = grow(dst, len(ids)*(EncodedSize+1))
+	for i, id := range ids {
+		if i > 0 {
+			dst = append(dst, sep)
+		}
+		n := len(dst)
+		dst = dst[:n+EncodedSize] // la capacité est réservée par grow
+		_ = id.MarshalTextTo(dst[n:])
+	}
+	return dst
+}
+
+// EncodeAll returns the string representation of ids. All the strings
+// share a single backing buffer, so encoding any number of ULIDs costs two
+// allocations: the buffer and the returned slice.
+func EncodeAll(ids []ULID) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	var b strings.Builder
+	b.Grow(len(ids) * EncodedSize)
+	var buf [EncodedSize]byte
+	for _, id := range ids {
+		_ = id.MarshalTextTo(buf[:])
+		b.Write(buf[:])
+	}
+	all := b.String()
+	res := make([]string, len(ids))
+	for i := range res {
+		res[i] = all[i*EncodedSize : (i+1)*EncodedSize]
+	}
+	return res
+}
+
+// MarshalList returns the text encoding of ids separated by sep, see
+// AppendList.
+func MarshalList(ids []ULID, sep byte) []byte {
+	return AppendList(nil, ids, sep)
+}
+
+// UnmarshalList parses a list of ULIDs separated by newlines or commas, as
+// written by MarshalList. Spaces, tabs and carriage returns around entries
+// are ignored, as is a single trailing separator. Entries are parsed with
+// ParseStrict; the first invalid or empty entry is reported as a *ListError.
+func UnmarshalList(data []byte) ([]ULID, error) {
+	ids := make([]ULID, 0, len(data)/(EncodedSize+1)+1)
+	line := 1
+	for i := 0; len(data) > 0; i++ {
+		entry, rest, sep := cutList(data)
+		var id ULID
+		if err := decode(&id, entry, true); err != nil {
+			return nil, &ListError{Index: i, Line: line, Err: err}
+		}
+		ids = append(ids, id)
+
+		if sep == '\n' {
+			line++
+		}
+		data = rest
+	}
+	return ids, nil
+}
+
+// cutList returns the first entry of data trimmed of blanks, the remaining
+// data and the separator found, 0 if none.
+func cutList(data []byte) (entry, rest []byte, sep byte) {
+	end := len(data)
+	for i, c := range data {
+		if c == '\n' || c == ',' {
+			end, sep, rest = i, c, data[i+1:]
+			break
+		}
+	}
+	entry = data[:end]
+	for len(entry) > 0 && isBlank(entry[0]) {
+		entry = entry[1:]
+	}
+	for len(entry) > 0 && isBlank(entry[len(entry)-1]) {
+		entry = entry[:len(entry)-1]
+	}
+	return entry, rest, sep
+}
+
+func isBlank(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r'
+}
+
+// grow makes room for n more bytes in b.
+func grow(b []byte, n int) []byte {
+	if cap(b)-len(b) < n {
+		nb := make([]byte, len(b), len(b)+n)
+		copy(nb, b)
+		b = nb
+	}
+	return b
+}
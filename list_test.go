@@ -0,0 +1,127 @@
+package ulid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestMarshalList(t *testing.T) {
+	ids := []ULID{Make(), Make(), Make()}
+
+	for _, sep := range []byte{'\n', ','} {
+		data := MarshalList(ids, sep)
+		got, err := UnmarshalList(data)
+		if err != nil {
+			t.Fatalf("UnmarshalList(%q) error = %v", data, err)
+		}
+		if len(got) != len(ids) {
+			t.Fatalf("UnmarshalList() = %v, want %v", got, ids)
+		}
+		for i := range ids {
+			if got[i] != ids[i] {
+				t.Errorf("UnmarshalList()[%d] = %v, want %v", i, got[i], ids[i])
+			}
+		}
+	}
+
+	if got := MarshalList(nil, '\n'); len(got) != 0 {
+		t.Errorf("MarshalList(nil) = %q, want empty", got)
+	}
+	if got := string(MarshalList(ids[:1], '\n')); got != ids[0].String()+"\n" {
+		t.Errorf("MarshalList() = %q", got)
+	}
+}
+
+func TestUnmarshalList(t *testing.T) {
+	a, b := Make().String(), Make().String()
+
+	valid := []string{
+		"",
+		a,
+		a + "\n" + b,
+		a + "\r\n" + b + "\r\n",
+		a + ", " + b + ",",
+		" " + a + "\t, " + b + " \n",
+	}
+	for _, s := range valid {
+		if _, err := UnmarshalList([]byte(s)); err != nil {
+			t.Errorf("UnmarshalList(%q) error = %v", s, err)
+		}
+	}
+
+	tests := []struct {
+		data  string
+		index int
+		line  int
+		err   error
+	}{
+		{a + "\n" + b + "\nnope", 2, 3, ErrDataSize},
+		{a + ",," + b, 1, 1, ErrDataSize},
+		{a + "\n\n", 1, 2, ErrDataSize},
+		{a + "\n" + b[:25] + "U", 1, 2, ErrInvalidCharacters},
+		{"8" + a[1:], 0, 1, ErrOverflow},
+	}
+	for _, tt := range tests {
+		_, err := UnmarshalList([]byte(tt.data))
+		var le *ListError
+		if !errors.As(err, &le) {
+			t.Errorf("UnmarshalList(%q) error = %v, want *ListError", tt.data, err)
+			continue
+		}
+		if le.Index != tt.index || le.Line != tt.line || !errors.Is(err, tt.err) {
+			t.Errorf("UnmarshalList(%q) error = %+v, want index %d line %d %v", tt.data, le, tt.index, tt.line, tt.err)
+		}
+	}
+}
+
+func TestEncodeAll(t *testing.T) {
+	ids := []ULID{Make(), Make(), Make()}
+
+	got := EncodeAll(ids)
+	if len(got) != len(ids) {
+		t.Fatalf("EncodeAll() = %v, want %d strings", got, len(ids))
+	}
+	for i, id := range ids {
+		if got[i] != id.String() {
+			t.Errorf("EncodeAll()[%d] = %s, want %s", i, got[i], id)
+		}
+	}
+	if got := EncodeAll(nil); got != nil {
+		t.Errorf("EncodeAll(nil) = %v, want nil", got)
+	}
+	if n := testing.AllocsPerRun(100, func() { _ = EncodeAll(ids) }); n != 2 {
+		t.Errorf("EncodeAll() allocs = %v, want 2", n)
+	}
+}
+
+func TestAppendAllText(t *testing.T) {
+	ids := []ULID{Make(), Make(), Make()}
+
+	got := AppendAllText([]byte("IN ("), ids, ',')
+	want := "IN (" + strings.Join(EncodeAll(ids), ",")
+	if string(got) != want {
+		t.Errorf("AppendAllText() = %s, want %s", got, want)
+	}
+	if got := AppendAllText(nil, nil, ','); len(got) != 0 {
+		t.Errorf("AppendAllText(nil) = %q, want empty", got)
+	}
+
+	dst := make([]byte, 0, len(ids)*(EncodedSize+1))
+	if n := testing.AllocsPerRun(100, func() { _ = AppendAllText(dst, ids, '\n') }); n != 0 {
+		t.Errorf("AppendAllText() allocs = %v, want 0", n)
+	}
+}
+
+func BenchmarkEncodeAll(b *testing.B) {
+	ids := make([]ULID, 1000)
+	for i := range ids {
+		ids[i] = Make()
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_ = EncodeAll(ids)
+	}
+}
@@ -0,0 +1,175 @@
+// Package migrate converts UUID primary keys into time-ordered ULIDs.
+//
+// The mapping is deterministic: a UUID and its creation time always give
+// the same ULID, so the conversion can be replayed in every environment
+// and foreign keys can be rewritten independently of the rows they point
+// to:
+//
+//	m := migrate.NewMapper()
+//	for rows.Next() {
+//		var u string
+//		var created time.Time
+//		_ = rows.Scan(&u, &created)
+//		id, err := m.MapString(u, created)
+//		...
+//	}
+package migrate
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+var (
+	// ErrUUID is returned when parsing a malformed UUID
+	ErrUUID = errors.New("migrate: invalid UUID")
+
+	// ErrCollision is returned by a Mapper when two UUIDs map to the same
+	// ULID
+	ErrCollision = errors.New("migrate: ULID collision")
+)
+
+// UUID is a UUID in its 16 bytes binary form.
+type UUID [16]byte
+
+// String returns the UUID in the 8-4-4-4-12 hex form.
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// Version returns the version number of the UUID, 4 for random UUIDs.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// ParseUUID parses a UUID in the 8-4-4-4-12 hex form, optionally enclosed
+// in braces or prefixed by "urn:uuid:", or as 32 hex digits.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	switch {
+	case len(s) == 45 && s[:9] == "urn:uuid:":
+		s = s[9:]
+	case len(s) == 38 && s[0] == '{' && s[37] == '}':
+		s = s[1:37]
+	}
+
+	var digits [32]byte
+	switch len(s) {
+	case 32:
+		copy(digits[:], s)
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return u, ErrUUID
+		}
+		copy(digits[:], s[:8]+s[9:13]+s[14:18]+s[19:23]+s[24:])
+	default:
+		return u, ErrUUID
+	}
+	if _, err := hex.Decode(u[:], digits[:]); err != nil {
+		return u, ErrUUID
+	}
+	return u, nil
+}
+
+// FromUUID maps u, created at the given time, to a ULID. The ULID time is
+// the creation time. For random (version 4) UUIDs the entropy is made of
+// the first 80 of their 122 random bits, the version and variant bits
+// being skipped; other versions carry less randomness, so their entropy is
+// taken from a SHA-256 hash of the UUID instead.
+//
+// ulid.ErrNegativeTime is returned if created is before the Unix epoch and
+// ulid.ErrBigTime if it is after ulid.MaxTime.
+func FromUUID(u UUID, created time.Time) (ulid.ULID, error) {
+	var entropy [10]byte
+	if u.Version() == 4 {
+		hi := binary.BigEndian.Uint64(u[:8])
+		lo := binary.BigEndian.Uint64(u[8:])
+		// 48 bits avant la version, 12 bits après, puis 20 bits après le variant
+		copy(entropy[:6], u[:6])
+		binary.BigEndian.PutUint32(entropy[6:], uint32(hi&0xFFF)<<20|uint32(lo>>42)&0xFFFFF)
+	} else {
+		sum := sha256.Sum256(u[:])
+		copy(entropy[:], sum[:])
+	}
+
+	if created.Before(time.UnixMilli(0)) {
+		return ulid.ULID{}, ulid.ErrNegativeTime
+	}
+	return ulid.FromParts(ulid.Timestamp(created), entropy)
+}
+
+// CollisionError is returned by a Mapper when two distinct UUIDs map to the
+// same ULID.
+type CollisionError struct {
+	// ID is the ULID both UUIDs map to
+	ID ulid.ULID
+
+	// UUID is the UUID being mapped and Other the one mapped before
+	UUID, Other UUID
+}
+
+func (e *CollisionError) Error() string {
+	return fmt.Sprintf("migrate: %s and %s both map to %s", e.UUID, e.Other, e.ID)
+}
+
+func (e *CollisionError) Unwrap() error {
+	return ErrCollision
+}
+
+// Mapper maps UUIDs with FromUUID and remembers the results to detect
+// collisions. Mapping the same UUID and time twice is not a collision.
+// It keeps every mapped ULID in memory.
+//
+// A Mapper is NOT safe for concurrent use.
+type Mapper struct {
+	seen map[ulid.ULID]UUID
+}
+
+// NewMapper returns an empty Mapper.
+func NewMapper() *Mapper {
+	return &Mapper{seen: make(map[ulid.ULID]UUID)}
+}
+
+// Map maps u like FromUUID, returning a *CollisionError if another UUID
+// already mapped to the same ULID.
+func (m *Mapper) Map(u UUID, created time.Time) (ulid.ULID, error) {
+	id, err := FromUUID(u, created)
+	if err != nil {
+		return ulid.ULID{}, err
+	}
+	if other, ok := m.seen[id]; ok && other != u {
+		return ulid.ULID{}, &CollisionError{ID: id, UUID: u, Other: other}
+	}
+	m.seen[id] = u
+	return id, nil
+}
+
+// MapString is like Map for a UUID in text form, see ParseUUID.
+func (m *Mapper) MapString(s string, created time.Time) (ulid.ULID, error) {
+	u, err := ParseUUID(s)
+	if err != nil {
+		return ulid.ULID{}, err
+	}
+	return m.Map(u, created)
+}
+
+// Len returns the number of distinct ULIDs mapped so far.
+func (m *Mapper) Len() int {
+	return len(m.seen)
+}
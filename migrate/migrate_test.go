@@ -0,0 +1,102 @@
+package migrate
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestParseUUID(t *testing.T) {
+	want := "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	for _, s := range []string{
+		want,
+		"F47AC10B-58CC-4372-A567-0E02B2C3D479",
+		"{f47ac10b-58cc-4372-a567-0e02b2c3d479}",
+		"urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d479",
+		"f47ac10b58cc4372a5670e02b2c3d479",
+	} {
+		u, err := ParseUUID(s)
+		if err != nil || u.String() != want {
+			t.Errorf("ParseUUID(%q) = %v, %v, want %s", s, u, err, want)
+		}
+	}
+	for _, s := range []string{"", "f47ac10b-58cc-4372-a567-0e02b2c3d47", "f47ac10b+58cc-4372-a567-0e02b2c3d479", "z47ac10b58cc4372a5670e02b2c3d479"} {
+		if _, err := ParseUUID(s); !errors.Is(err, ErrUUID) {
+			t.Errorf("ParseUUID(%q) error = %v, want %v", s, err, ErrUUID)
+		}
+	}
+}
+
+func TestFromUUID(t *testing.T) {
+	u, _ := ParseUUID("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	created := time.UnixMilli(1469918176385)
+
+	id, err := FromUUID(u, created)
+	if err != nil {
+		t.Fatalf("FromUUID() error = %v", err)
+	}
+	// Pinned value: the mapping must be reproducible
+	if want := ulid.MustParse("01ARYZ6S41YHXC22TRSGVJJPE3"); id != want {
+		t.Errorf("FromUUID() = %v, want %v", id, want)
+	}
+	if id.Time() != ulid.Timestamp(created) {
+		t.Errorf("FromUUID().Time() = %v, want %v", id.Time(), ulid.Timestamp(created))
+	}
+	if e := id.Entropy(); string(e[:6]) != string(u[:6]) {
+		t.Errorf("FromUUID() entropy = %x, want prefix %x", e, u[:6])
+	}
+
+	// Non random UUIDs are hashed
+	v1, _ := ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	id1, err := FromUUID(v1, created)
+	if err != nil {
+		t.Fatalf("FromUUID(v1) error = %v", err)
+	}
+	if e := id1.Entropy(); string(e[:6]) == string(v1[:6]) {
+		t.Errorf("FromUUID(v1) entropy = %x, want hashed", e)
+	}
+
+	if _, err := FromUUID(u, time.UnixMilli(-1)); !errors.Is(err, ulid.ErrNegativeTime) {
+		t.Errorf("FromUUID(before epoch) error = %v, want %v", err, ulid.ErrNegativeTime)
+	}
+	if _, err := FromUUID(u, ulid.Time(ulid.MaxTime+1)); !errors.Is(err, ulid.ErrBigTime) {
+		t.Errorf("FromUUID(after MaxTime) error = %v, want %v", err, ulid.ErrBigTime)
+	}
+}
+
+func TestMapper(t *testing.T) {
+	m := NewMapper()
+	created := time.UnixMilli(1469918176385)
+	a, _ := ParseUUID("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+
+	id, err := m.Map(a, created)
+	if err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	if again, err := m.Map(a, created); err != nil || again != id {
+		t.Errorf("Map() again = %v, %v, want %v", again, err, id)
+	}
+
+	// b only differs from a in discarded bits
+	b := a
+	b[15] ^= 1
+	var ce *CollisionError
+	if _, err := m.Map(b, created); !errors.As(err, &ce) || !errors.Is(err, ErrCollision) {
+		t.Fatalf("Map(b) error = %v, want *CollisionError", err)
+	}
+	if ce.ID != id || ce.UUID != b || ce.Other != a {
+		t.Errorf("CollisionError = %+v", ce)
+	}
+
+	if _, err := m.MapString("f47ac10b-58cc-4372-a567-0e02b2c3d479", created.Add(time.Millisecond)); err != nil {
+		t.Errorf("MapString() error = %v", err)
+	}
+	if _, err := m.MapString("nope", created); !errors.Is(err, ErrUUID) {
+		t.Errorf("MapString(nope) error = %v, want %v", err, ErrUUID)
+	}
+	if m.Len() != 2 {
+		t.Errorf("Len() = %v, want 2", m.Len())
+	}
+}
@@ -0,0 +1,134 @@
+package ulid
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+)
+
+// ErrNotMonotonic is returned when a stream of ULIDs is not strictly
+// increasing.
+var ErrNotMonotonic = errors.New("ulid: stream is not monotonic")
+
+// Violation is a ULID that is not greater than the one before it.
+type Violation struct {
+	// Index is the position of ID in the stream, from 0
+	Index int
+
+	// Prev is the ULID preceding ID in the stream
+	Prev, ID ULID
+}
+
+func (v Violation) String() string {
+	return fmt.Sprintf("#%d %s <= %s", v.Index, v.ID, v.Prev)
+}
+
+// MonotonicError is returned by Checker.Err and VerifyMonotonic when
+// violations were found. It wraps ErrNotMonotonic.
+type MonotonicError struct {
+	// Count is the number of violations
+	Count int
+
+	// First is the first violation of the stream
+	First Violation
+}
+
+func (e *MonotonicError) Error() string {
+	return fmt.Sprintf("ulid: stream is not monotonic: %d violation(s), first at %s", e.Count, e.First)
+}
+
+func (e *MonotonicError) Unwrap() error {
+	return ErrNotMonotonic
+}
+
+// DefaultMaxViolations is the number of violations kept by a zero Checker
+// and by VerifyMonotonic.
+const DefaultMaxViolations = 100
+
+// Checker verifies, one ULID at a time, that a stream is strictly
+// increasing, as produced by a monotonic generator. Duplicates are
+// violations. It counts every violation but only keeps the first ones, up
+// to DefaultMaxViolations for a zero Checker, so its memory use does not
+// depend on the size of the stream.
+//
+// A Checker is NOT safe for concurrent use.
+type Checker struct {
+	n          int
+	prev       ULID
+	max        int // 0 pour DefaultMaxViolations, < 0 pour aucune
+	count      int
+	first      Violation
+	violations []Violation
+}
+
+// NewChecker returns a Checker keeping the first max violations. A Checker
+// with max <= 0 keeps none but still counts them.
+func NewChecker(max int) *Checker {
+	return &Checker{max: cmp.Or(max, -1)}
+}
+
+// Add checks the next ULID of the stream. It returns false if id is not
+// greater than the previous one.
+func (c *Checker) Add(id ULID) bool {
+	ok := c.n == 0 || id.Compare(c.prev) > 0
+	if !ok {
+		v := Violation{Index: c.n, Prev: c.prev, ID: id}
+		if c.count == 0 {
+			c.first = v
+		}
+		c.count++
+		if len(c.violations) < c.limit() {
+			c.violations = append(c.violations, v)
+		}
+	}
+	c.prev = id
+	c.n++
+	return ok
+}
+
+func (c *Checker) limit() int {
+	if c.max == 0 {
+		return DefaultMaxViolations
+	}
+	return c.max
+}
+
+// Len returns the number of ULIDs checked so far.
+func (c *Checker) Len() int {
+	return c.n
+}
+
+// Count returns the number of violations found so far, including the ones
+// that were not kept.
+func (c *Checker) Count() int {
+	return c.count
+}
+
+// Violations returns the first violations found so far, in stream order.
+// Use Count for their total number.
+func (c *Checker) Violations() []Violation {
+	return c.violations
+}
+
+// Err returns a *MonotonicError if violations were found, nil otherwise.
+func (c *Checker) Err() error {
+	if c.count == 0 {
+		return nil
+	}
+	return &MonotonicError{Count: c.count, First: c.first}
+}
+
+// VerifyMonotonic checks that ids is strictly increasing. It returns the
+// index of the first DefaultMaxViolations violating ULIDs and a
+// *MonotonicError holding their total count, see Checker.
+func VerifyMonotonic(ids iter.Seq[ULID]) (violations []int, err error) {
+	var c Checker
+	for id := range ids {
+		c.Add(id)
+	}
+	for _, v := range c.violations {
+		violations = append(violations, v.Index)
+	}
+	return violations, c.Err()
+}
@@ -0,0 +1,103 @@
+package ulid
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestVerifyMonotonic(t *testing.T) {
+	a, b, c := MustNew(1, nil), MustNew(2, nil), MustNew(3, nil)
+
+	tests := []struct {
+		ids  []ULID
+		want []int
+	}{
+		{nil, nil},
+		{[]ULID{a, b, c}, nil},
+		{[]ULID{a, c, b}, []int{2}},
+		{[]ULID{a, a, b, b, a}, []int{1, 3, 4}},
+	}
+	for _, tt := range tests {
+		got, err := VerifyMonotonic(slices.Values(tt.ids))
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("VerifyMonotonic(%v) = %v, want %v", tt.ids, got, tt.want)
+		}
+		if (err != nil) != (tt.want != nil) {
+			t.Errorf("VerifyMonotonic(%v) error = %v", tt.ids, err)
+		}
+	}
+}
+
+func TestChecker(t *testing.T) {
+	a, b, c := MustNew(1, nil), MustNew(2, nil), MustNew(3, nil)
+
+	var ch Checker
+	if !ch.Add(a) || !ch.Add(c) || ch.Add(b) || !ch.Add(c) {
+		t.Error("Add() returned wrong result")
+	}
+	if ch.Len() != 4 {
+		t.Errorf("Len() = %v, want 4", ch.Len())
+	}
+
+	want := Violation{Index: 2, Prev: c, ID: b}
+	if v := ch.Violations(); len(v) != 1 || v[0] != want {
+		t.Errorf("Violations() = %v, want [%v]", v, want)
+	}
+
+	var me *MonotonicError
+	err := ch.Err()
+	if !errors.As(err, &me) || !errors.Is(err, ErrNotMonotonic) {
+		t.Fatalf("Err() = %v, want *MonotonicError", err)
+	}
+	if me.Count != 1 || me.First != want {
+		t.Errorf("Err() = %+v, want first %v", me, want)
+	}
+
+	if err := new(Checker).Err(); err != nil {
+		t.Errorf("Err() on empty Checker = %v, want nil", err)
+	}
+}
+
+func TestCheckerLimit(t *testing.T) {
+	a, b := MustNew(1, nil), MustNew(2, nil)
+
+	for _, tt := range []struct {
+		ch   *Checker
+		want int
+	}{
+		{new(Checker), DefaultMaxViolations},
+		{NewChecker(3), 3},
+		{NewChecker(0), 0},
+	} {
+		tt.ch.Add(b)
+		for range 2 * DefaultMaxViolations {
+			tt.ch.Add(a)
+		}
+		if tt.ch.Count() != 2*DefaultMaxViolations {
+			t.Errorf("Count() = %v, want %v", tt.ch.Count(), 2*DefaultMaxViolations)
+		}
+		v := tt.ch.Violations()
+		if len(v) != tt.want {
+			t.Errorf("len(Violations()) = %v, want %v", len(v), tt.want)
+		}
+		if len(v) > 0 && v[len(v)-1].Index != tt.want {
+			t.Errorf("Violations() last index = %v, want %v", v[len(v)-1].Index, tt.want)
+		}
+
+		var me *MonotonicError
+		if !errors.As(tt.ch.Err(), &me) || me.Count != 2*DefaultMaxViolations || me.First.Index != 1 {
+			t.Errorf("Err() = %v, want %d violations, first at 1", tt.ch.Err(), 2*DefaultMaxViolations)
+		}
+	}
+
+	ids := []ULID{b}
+	for range 2 * DefaultMaxViolations {
+		ids = append(ids, a)
+	}
+	got, err := VerifyMonotonic(slices.Values(ids))
+	var me *MonotonicError
+	if len(got) != DefaultMaxViolations || !errors.As(err, &me) || me.Count != 2*DefaultMaxViolations {
+		t.Errorf("VerifyMonotonic() = %d indexes, %v, want %d indexes", len(got), err, DefaultMaxViolations)
+	}
+}
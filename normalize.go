@@ -0,0 +1,98 @@
+package ulid
+
+import (
+	"strings"
+)
+
+// Normalize returns the canonical form of an encoded ULID typed or copied by
+// a human: surrounding whitespace and hyphens are removed, letters are
+// uppercased and the Crockford aliases I and L are read as 1, O as 0.
+//
+// ErrDataSize, ErrInvalidCharacters and ErrOverflow are returned like by
+// ParseStrict when the cleaned input is not a valid ULID.
+func Normalize(s string) (string, error) {
+	s = strings.TrimSpace(s)
+
+	var buf [EncodedSize]byte
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '-':
+			continue
+		case 'I', 'i', 'L', 'l':
+			c = '1'
+		case 'O', 'o':
+			c = '0'
+		default:
+			if 'a' <= c && c <= 'z' {
+				c -= 'a' - 'A'
+			}
+		}
+		if n == EncodedSize {
+			return "", ErrDataSize
+		}
+		buf[n] = c
+		n++
+	}
+
+	var id ULID
+	if err := decode(&id, buf[:n], true); err != nil {
+		return "", err
+	}
+	return string(buf[:n]), nil
+}
+
+// ParseLoose is like ParseStrict, but first trims surrounding whitespace and
+// a pair of matching quotes (", ' or `), as left around IDs copied from JSON
+// logs or spreadsheets. Whitespace inside the quotes is trimmed too.
+func ParseLoose(s string) (ULID, error) {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 {
+		if q := s[0]; (q == '"' || q == '\'' || q == '`') && s[len(s)-1] == q {
+			s = strings.TrimSpace(s[1 : len(s)-1])
+		}
+	}
+	return ParseStrict(s)
+}
+
+// ChunkSeparators are the separators skipped by ParseChunked.
+const ChunkSeparators = " -._:/"
+
+// FormatChunked returns the ULID in groups of group characters separated by
+// sep, e.g. "01AN-4Z07-BY79-KA13-07SR-9X4M-V3" for ('-', 4), which is
+// easier to read out or copy by hand than 26 characters in a row. ULIDs
+// formatted with one of the ChunkSeparators are read back by ParseChunked.
+// A group <= 0 or >= EncodedSize returns the canonical form.
+func (id ULID) FormatChunked(sep byte, group int) string {
+	if group <= 0 || group >= EncodedSize {
+		return id.String()
+	}
+	a := id.StringArray()
+	buf := make([]byte, 0, EncodedSize+(EncodedSize-1)/group)
+	for i := 0; i < EncodedSize; i += group {
+		if i > 0 {
+			buf = append(buf, sep)
+		}
+		buf = append(buf, a[i:min(i+group, EncodedSize)]...)
+	}
+	return string(buf)
+}
+
+// ParseChunked parses a ULID formatted by FormatChunked, skipping any of the
+// ChunkSeparators wherever they are. The remaining characters are read like
+// by Normalize, tolerating lower case letters and the I, L and O aliases.
+func ParseChunked(s string) (ULID, error) {
+	var buf [2 * EncodedSize]byte
+	b := buf[:0]
+	for i := 0; i < len(s); i++ {
+		if strings.IndexByte(ChunkSeparators, s[i]) < 0 {
+			b = append(b, s[i])
+		}
+	}
+	n, err := Normalize(string(b))
+	if err != nil {
+		return ULID{}, err
+	}
+	return ParseStrict(n)
+}
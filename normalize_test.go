@@ -0,0 +1,118 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	const canonical = "01AN4Z07BY79KA1307SR9X4MV3"
+
+	tests := []struct {
+		in   string
+		want string
+		err  error
+	}{
+		{canonical, canonical, nil},
+		{"01an4z07by79ka1307sr9x4mv3", canonical, nil},
+		{"  01AN4Z07BY79KA1307SR9X4MV3\n", canonical, nil},
+		{"01AN4Z07-BY79KA13-07SR9X4MV3", canonical, nil},
+		{"oIAN4Z07BY79KAl3o7SR9X4MV3", canonical, nil},
+		{"01AN4Z07BY79KA1307SR9X4MV", "", ErrDataSize},
+		{"01AN4Z07BY79KA1307SR9X4MV33", "", ErrDataSize},
+		{"01AN4Z07BY79KA1307SR9X4MVU", "", ErrInvalidCharacters},
+		{"01AN4Z07BY79KA1307SR9X4M 3", "", ErrInvalidCharacters},
+		{"8ZZZZZZZZZZZZZZZZZZZZZZZZZ", "", ErrOverflow},
+		{"", "", ErrDataSize},
+	}
+	for _, tt := range tests {
+		got, err := Normalize(tt.in)
+		if got != tt.want || err != tt.err {
+			t.Errorf("Normalize(%q) = %q, %v, want %q, %v", tt.in, got, err, tt.want, tt.err)
+		}
+	}
+}
+
+func TestParseLoose(t *testing.T) {
+	id := Make()
+	s := id.String()
+
+	valid := []string{
+		s,
+		" " + s + "\r\n",
+		`"` + s + `"`,
+		"\t'" + s + "'\n",
+		"`" + s + "`",
+		`" ` + s + ` "`,
+	}
+	for _, in := range valid {
+		got, err := ParseLoose(in)
+		if err != nil || got != id {
+			t.Errorf("ParseLoose(%q) = %v, %v, want %v", in, got, err, id)
+		}
+	}
+
+	invalid := []string{
+		`"` + s + `'`,
+		`"` + s,
+		`""` + s + `""`,
+		`"`,
+	}
+	for _, in := range invalid {
+		if _, err := ParseLoose(in); err == nil {
+			t.Errorf("ParseLoose(%q) should fail", in)
+		}
+	}
+}
+
+func TestFormatChunked(t *testing.T) {
+	id := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+
+	tests := []struct {
+		sep   byte
+		group int
+		want  string
+	}{
+		{'-', 4, "01AN-4Z07-BY79-KA13-07SR-9X4M-V3"},
+		{' ', 5, "01AN4 Z07BY 79KA1 307SR 9X4MV 3"},
+		{'.', 13, "01AN4Z07BY79K.A1307SR9X4MV3"},
+		{'-', 0, "01AN4Z07BY79KA1307SR9X4MV3"},
+		{'-', 26, "01AN4Z07BY79KA1307SR9X4MV3"},
+	}
+	for _, tt := range tests {
+		got := id.FormatChunked(tt.sep, tt.group)
+		if got != tt.want {
+			t.Errorf("FormatChunked(%q, %d) = %s, want %s", tt.sep, tt.group, got, tt.want)
+		}
+		if back, err := ParseChunked(got); err != nil || back != id {
+			t.Errorf("ParseChunked(%s) = %v, %v, want %v", got, back, err, id)
+		}
+	}
+}
+
+func TestParseChunked(t *testing.T) {
+	id := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+
+	for _, s := range []string{
+		"01an-4z07-by79-ka13-07sr-9x4m-v3",
+		"OIAN 4Z07 BY79 KA13 07SR 9X4M V3",
+		"01AN4Z07:BY79KA13/07SR9X4M_V3",
+	} {
+		if got, err := ParseChunked(s); err != nil || got != id {
+			t.Errorf("ParseChunked(%s) = %v, %v, want %v", s, got, err, id)
+		}
+	}
+
+	tests := []struct {
+		s   string
+		err error
+	}{
+		{"01AN-4Z07-BY79-KA13-07SR-9X4M", ErrDataSize},
+		{"01AN-4Z07-BY79-KA13-07SR-9X4M-V3-00", ErrDataSize},
+		{"01AN-4Z07-BY79-KA13-07SR-9X4M-V!", ErrInvalidCharacters},
+	}
+	for _, tt := range tests {
+		if _, err := ParseChunked(tt.s); err != tt.err {
+			t.Errorf("ParseChunked(%s) error = %v, want %v", tt.s, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package ulid
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+)
+
+// Obfuscator applies a keyed permutation over the 128 bits of ULIDs so that
+// public IDs reveal neither their creation time nor the creation rate.
+// The permutation is AES applied to the single 16 byte block, so every
+// obfuscated value maps back to exactly one ULID.
+//
+// Obfuscated ULIDs are not sortable. Deobfuscate them server-side to
+// recover the original ordering. An Obfuscator is safe for concurrent use.
+type Obfuscator struct {
+	block cipher.Block
+}
+
+// NewObfuscator returns an Obfuscator using the given AES key, which must
+// be 16, 24 or 32 bytes long.
+func NewObfuscator(key []byte) (*Obfuscator, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Obfuscator{block: block}, nil
+}
+
+// Obfuscate returns the obfuscated form of id.
+func (o *Obfuscator) Obfuscate(id ULID) ULID {
+	var res ULID
+	o.block.Encrypt(res[:], id[:])
+	return res
+}
+
+// Deobfuscate returns the original ULID of an obfuscated id.
+func (o *Obfuscator) Deobfuscate(id ULID) ULID {
+	var res ULID
+	o.block.Decrypt(res[:], id[:])
+	return res
+}
+
+// Obfuscate returns the obfuscated form of id under key. Use an Obfuscator
+// to avoid setting up the cipher on every call.
+func (id ULID) Obfuscate(key []byte) (ULID, error) {
+	o, err := NewObfuscator(key)
+	if err != nil {
+		return ULID{}, err
+	}
+	return o.Obfuscate(id), nil
+}
+
+// Deobfuscate returns the original ULID of an id obfuscated under key.
+func (id ULID) Deobfuscate(key []byte) (ULID, error) {
+	o, err := NewObfuscator(key)
+	if err != nil {
+		return ULID{}, err
+	}
+	return o.Deobfuscate(id), nil
+}
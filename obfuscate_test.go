@@ -0,0 +1,35 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestObfuscate(t *testing.T) {
+	key := []byte("0123456789abcdef")
+	id := Make()
+
+	obf, err := id.Obfuscate(key)
+	if err != nil {
+		t.Fatalf("Obfuscate() error = %v", err)
+	}
+	if obf == id || obf.Time() == id.Time() {
+		t.Error("Obfuscate() should hide the timestamp")
+	}
+
+	back, err := obf.Deobfuscate(key)
+	if err != nil {
+		t.Fatalf("Deobfuscate() error = %v", err)
+	}
+	if back != id {
+		t.Errorf("Deobfuscate(Obfuscate()) = %v, want %v", back, id)
+	}
+
+	other, _ := obf.Deobfuscate([]byte("fedcba9876543210"))
+	if other == id {
+		t.Error("Deobfuscate() with a wrong key should not recover the ULID")
+	}
+
+	if _, err := id.Obfuscate([]byte("short")); err == nil {
+		t.Error("Obfuscate() with an invalid key should fail")
+	}
+}
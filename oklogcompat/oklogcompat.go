@@ -0,0 +1,229 @@
+// Package oklogcompat mirrors the API of github.com/oklog/ulid/v2 on top
+// of this module, so that a project can switch with a change of import
+// path only:
+//
+//	import ulid "github.com/kamalshkeir/ulid/oklogcompat"
+//
+// ULID is an alias of ulid.ULID and the errors are the ones of package
+// ulid, so values and errors.Is checks work across both packages and the
+// extra features can be adopted gradually. Functions whose signature or
+// behaviour differs in package ulid, such as New with a nil entropy or
+// MaxTime, behave like their oklog counterparts here.
+package oklogcompat
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"math"
+	"math/bits"
+	"sync"
+	"time"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+// ULID is a 16 byte Universally Unique Lexicographically Sortable Identifier.
+type ULID = ulid.ULID
+
+const (
+	// EncodedSize is the length of a text encoded ULID
+	EncodedSize = ulid.EncodedSize
+
+	// Encoding is the base32 alphabet of Crockford used to encode ULIDs
+	Encoding = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+var (
+	ErrDataSize          = ulid.ErrDataSize
+	ErrInvalidCharacters = ulid.ErrInvalidCharacters
+	ErrBufferSize        = ulid.ErrBufferSize
+	ErrBigTime           = ulid.ErrBigTime
+	ErrOverflow          = ulid.ErrOverflow
+	ErrMonotonicOverflow = ulid.ErrMonotonicOverflow
+	ErrScanValue         = ulid.ErrScanValue
+)
+
+// Zero is the zero ULID.
+var Zero ULID
+
+// New returns a ULID with the given Unix milliseconds timestamp and entropy
+// source. Unlike ulid.New, a nil entropy leaves the entropy zero, and
+// MonotonicReaders are read with MonotonicRead.
+func New(ms uint64, entropy io.Reader) (id ULID, err error) {
+	if err = id.SetTime(ms); err != nil {
+		return id, err
+	}
+	switch e := entropy.(type) {
+	case nil:
+		return id, nil
+	case MonotonicReader:
+		err = e.MonotonicRead(ms, id[6:])
+	default:
+		_, err = io.ReadFull(e, id[6:])
+	}
+	return id, err
+}
+
+// MustNew is like New, but panics on failure.
+func MustNew(ms uint64, entropy io.Reader) ULID {
+	id, err := New(ms, entropy)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// Make returns a ULID with the current time and DefaultEntropy. It panics
+// on failure.
+func Make() ULID {
+	return MustNew(Now(), defaultEntropy)
+}
+
+// Parse parses an encoded ULID, see ulid.Parse.
+func Parse(s string) (ULID, error) {
+	return ulid.Parse(s)
+}
+
+// ParseStrict parses an encoded ULID, rejecting invalid characters, see
+// ulid.ParseStrict.
+func ParseStrict(s string) (ULID, error) {
+	return ulid.ParseStrict(s)
+}
+
+// MustParse is like Parse, but panics on failure.
+func MustParse(s string) ULID {
+	return ulid.MustParse(s)
+}
+
+// MustParseStrict is like ParseStrict, but panics on failure.
+func MustParseStrict(s string) ULID {
+	id, err := ulid.ParseStrict(s)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// Now returns the current time in Unix milliseconds.
+func Now() uint64 {
+	return Timestamp(time.Now())
+}
+
+// Timestamp converts t to Unix milliseconds.
+func Timestamp(t time.Time) uint64 {
+	return ulid.Timestamp(t)
+}
+
+// Time converts Unix milliseconds to a time.Time in the local time zone.
+func Time(ms uint64) time.Time {
+	return time.UnixMilli(int64(ms))
+}
+
+// MaxTime returns the largest timestamp of a ULID.
+func MaxTime() uint64 {
+	return ulid.MaxTime
+}
+
+var defaultEntropy = &LockedMonotonicReader{MonotonicReader: Monotonic(rand.Reader, 0)}
+
+// DefaultEntropy returns the entropy source used by Make: a monotonic
+// reader over crypto/rand, safe for concurrent use.
+func DefaultEntropy() io.Reader {
+	return defaultEntropy
+}
+
+// MonotonicReader is an entropy source producing increasing entropy for
+// ULIDs created within the same millisecond.
+type MonotonicReader interface {
+	io.Reader
+	MonotonicRead(ms uint64, p []byte) error
+}
+
+// Monotonic returns an entropy source that reads fresh entropy from
+// entropy for every new millisecond, and otherwise increments the previous
+// entropy by a random amount in [1, inc]. An inc of 0 means
+// math.MaxUint32. ErrMonotonicOverflow is returned when the increment
+// overflows the 80 bits of entropy.
+//
+// The returned source is NOT safe for concurrent use, see
+// LockedMonotonicReader.
+func Monotonic(entropy io.Reader, inc uint64) *MonotonicEntropy {
+	if inc == 0 {
+		inc = math.MaxUint32
+	}
+	return &MonotonicEntropy{Reader: entropy, inc: inc}
+}
+
+// MonotonicEntropy is the MonotonicReader returned by Monotonic.
+type MonotonicEntropy struct {
+	io.Reader
+	ms  uint64
+	inc uint64
+
+	// Entropie courante sur 80 bits
+	hi uint16
+	lo uint64
+}
+
+// MonotonicRead implements the MonotonicReader interface.
+func (m *MonotonicEntropy) MonotonicRead(ms uint64, p []byte) error {
+	if len(p) != ulid.EntropySize {
+		return ErrDataSize
+	}
+	if m.ms != ms || m.hi == 0 && m.lo == 0 {
+		if _, err := io.ReadFull(m.Reader, p); err != nil {
+			return err
+		}
+		m.ms = ms
+		m.hi = binary.BigEndian.Uint16(p)
+		m.lo = binary.BigEndian.Uint64(p[2:])
+		return nil
+	}
+
+	inc, err := m.random()
+	if err != nil {
+		return err
+	}
+	lo, carry := bits.Add64(m.lo, inc, 0)
+	hi := m.hi + uint16(carry)
+	if hi < m.hi {
+		return ErrMonotonicOverflow
+	}
+	m.hi, m.lo = hi, lo
+	binary.BigEndian.PutUint16(p, m.hi)
+	binary.BigEndian.PutUint64(p[2:], m.lo)
+	return nil
+}
+
+// random returns an increment uniformly drawn in [1, m.inc].
+func (m *MonotonicEntropy) random() (uint64, error) {
+	if m.inc == 1 {
+		return 1, nil
+	}
+	// Tirage par rejet sur le nombre de bits de inc-1
+	mask := uint64(1)<<bits.Len64(m.inc-1) - 1
+	var buf [8]byte
+	for {
+		if _, err := io.ReadFull(m.Reader, buf[:]); err != nil {
+			return 0, err
+		}
+		if n := binary.BigEndian.Uint64(buf[:]) & mask; n < m.inc {
+			return n + 1, nil
+		}
+	}
+}
+
+// LockedMonotonicReader wraps a MonotonicReader with a mutex, making it
+// safe for concurrent use.
+type LockedMonotonicReader struct {
+	mu sync.Mutex
+	MonotonicReader
+}
+
+// MonotonicRead synchronizes calls to the wrapped MonotonicReader.
+func (r *LockedMonotonicReader) MonotonicRead(ms uint64, p []byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.MonotonicReader.MonotonicRead(ms, p)
+}
@@ -0,0 +1,76 @@
+package oklogcompat
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestNew(t *testing.T) {
+	id, err := New(42, nil)
+	if err != nil || id.Time() != 42 || !bytes.Equal(id.Entropy(), make([]byte, 10)) {
+		t.Errorf("New(42, nil) = %v, %v, want zero entropy", id, err)
+	}
+	if _, err := New(MaxTime()+1, nil); !errors.Is(err, ulid.ErrBigTime) {
+		t.Errorf("New(MaxTime()+1) error = %v, want %v", err, ulid.ErrBigTime)
+	}
+	if _, err := ParseStrict("01AN4Z07BY79KA1307SR9X4MVU"); !errors.Is(err, ulid.ErrInvalidCharacters) {
+		t.Errorf("ParseStrict() error = %v, want %v", err, ulid.ErrInvalidCharacters)
+	}
+}
+
+func TestMake(t *testing.T) {
+	prev := Make()
+	for i := 0; i < 1000; i++ {
+		id := Make()
+		if id.Compare(prev) <= 0 {
+			t.Fatalf("Make() = %v, not greater than %v", id, prev)
+		}
+		prev = id
+	}
+}
+
+func TestMonotonic(t *testing.T) {
+	seed := []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFD}
+	m := Monotonic(bytes.NewReader(seed), 1)
+
+	a := MustNew(1, m)
+	b := MustNew(1, m)
+	if a.Compare(b) >= 0 || b.Entropy()[9] != 0xFE {
+		t.Errorf("MustNew() = %v, %v, want increment by 1", a, b)
+	}
+	_ = MustNew(1, m)
+	if _, err := New(1, m); err != ErrMonotonicOverflow {
+		t.Errorf("New() error = %v, want %v", err, ErrMonotonicOverflow)
+	}
+
+	// Nouvelle milliseconde : nouvelle entropie, ici épuisée
+	if _, err := New(2, m); err == nil {
+		t.Error("New() with an exhausted reader should fail")
+	}
+}
+
+func TestMonotonicRandomIncrement(t *testing.T) {
+	const inc = 10
+	m := Monotonic(bytes.NewReader(bytes.Repeat([]byte{0x03}, 1000)), inc)
+	prev := MustNew(1, m)
+	for i := 0; i < 50; i++ {
+		id := MustNew(1, m)
+		d := id.Entropy()[9] - prev.Entropy()[9]
+		if d < 1 || d > inc {
+			t.Fatalf("increment = %d, want in [1, %d]", d, inc)
+		}
+		prev = id
+	}
+}
+
+func TestMustParseStrict(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseStrict() should panic on invalid input")
+		}
+	}()
+	MustParseStrict("not a ulid")
+}
@@ -0,0 +1,45 @@
+package ulid
+
+import "encoding/binary"
+
+// signBit flips the order of unsigned values stored in signed integers.
+const signBit = 1 << 63
+
+// Int64Pair returns the ULID as two signed integers, for engines storing
+// IDs in two BIGINT columns. hi holds the big-endian first 8 bytes and lo
+// the last 8, each with its sign bit flipped, so that ordering the pairs as
+// signed integers (ORDER BY hi, lo) orders them like the ULIDs. As a
+// consequence hi is negative for any ULID created after 1970.
+func (id ULID) Int64Pair() (hi, lo int64) {
+	return int64(binary.BigEndian.Uint64(id[:8]) ^ signBit), int64(binary.BigEndian.Uint64(id[8:]) ^ signBit)
+}
+
+// FromInt64Pair returns the ULID stored in hi and lo by Int64Pair.
+func FromInt64Pair(hi, lo int64) ULID {
+	var id ULID
+	binary.BigEndian.PutUint64(id[:8], uint64(hi)^signBit)
+	binary.BigEndian.PutUint64(id[8:], uint64(lo)^signBit)
+	return id
+}
+
+// Uint32s returns the ULID as four 32-bit words, for SIMD or GPU pipelines
+// and FFI layers moving IDs as lanes. Word 0 holds the big-endian first 4
+// bytes and word 3 the last 4, so comparing the arrays word by word, from
+// 0 to 3, orders them like the ULIDs regardless of the host byte order.
+func (id ULID) Uint32s() [4]uint32 {
+	return [4]uint32{
+		binary.BigEndian.Uint32(id[0:]),
+		binary.BigEndian.Uint32(id[4:]),
+		binary.BigEndian.Uint32(id[8:]),
+		binary.BigEndian.Uint32(id[12:]),
+	}
+}
+
+// FromUint32s returns the ULID stored in w by Uint32s.
+func FromUint32s(w [4]uint32) ULID {
+	var id ULID
+	for i, v := range w {
+		binary.BigEndian.PutUint32(id[4*i:], v)
+	}
+	return id
+}
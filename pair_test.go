@@ -0,0 +1,71 @@
+package ulid
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestInt64Pair(t *testing.T) {
+	tests := []struct {
+		id     ULID
+		hi, lo int64
+	}{
+		{ULID{}, math.MinInt64, math.MinInt64},
+		{MustParse("7ZZZZZZZZZZZZZZZZZZZZZZZZZ"), math.MaxInt64, math.MaxInt64},
+		{ULID{7: 1, 8: 0x80}, math.MinInt64 + 1, 0},
+	}
+	for _, tt := range tests {
+		hi, lo := tt.id.Int64Pair()
+		if hi != tt.hi || lo != tt.lo {
+			t.Errorf("Int64Pair(%x) = %d, %d, want %d, %d", tt.id[:], hi, lo, tt.hi, tt.lo)
+		}
+		if got := FromInt64Pair(hi, lo); got != tt.id {
+			t.Errorf("FromInt64Pair(%d, %d) = %v, want %v", hi, lo, got, tt.id)
+		}
+	}
+}
+
+func TestInt64PairOrder(t *testing.T) {
+	ids := []ULID{{}, {8: 0x80}, {8: 0x7F}, {0: 0x80}, Make(), Make(), MustParse("7ZZZZZZZZZZZZZZZZZZZZZZZZZ")}
+	type pair struct{ hi, lo int64 }
+	pairs := make([]pair, len(ids))
+	for i, id := range ids {
+		pairs[i].hi, pairs[i].lo = id.Int64Pair()
+	}
+
+	Sort(ids)
+	slices.SortFunc(pairs, func(a, b pair) int {
+		return cmp.Or(cmp.Compare(a.hi, b.hi), cmp.Compare(a.lo, b.lo))
+	})
+	for i := range ids {
+		if got := FromInt64Pair(pairs[i].hi, pairs[i].lo); got != ids[i] {
+			t.Errorf("pair #%d sorts as %v, want %v", i, got, ids[i])
+		}
+	}
+}
+
+func TestUint32s(t *testing.T) {
+	id := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	want := [4]uint32{0x015549f0, 0x1d7e3a66, 0xa08c07ce, 0x13d25363}
+	if got := id.Uint32s(); got != want {
+		t.Errorf("Uint32s() = %#x, want %#x", got, want)
+	}
+	if got := FromUint32s(want); got != id {
+		t.Errorf("FromUint32s() = %v, want %v", got, id)
+	}
+
+	ids := []ULID{{}, {3: 1}, {4: 0xFF}, {15: 1}, Make(), MustParse("7ZZZZZZZZZZZZZZZZZZZZZZZZZ")}
+	words := make([][4]uint32, len(ids))
+	for i, id := range ids {
+		words[i] = id.Uint32s()
+	}
+	Sort(ids)
+	slices.SortFunc(words, func(a, b [4]uint32) int { return slices.Compare(a[:], b[:]) })
+	for i := range ids {
+		if got := FromUint32s(words[i]); got != ids[i] {
+			t.Errorf("words #%d sort as %v, want %v", i, got, ids[i])
+		}
+	}
+}
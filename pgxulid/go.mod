@@ -0,0 +1,10 @@
+module github.com/kamalshkeir/ulid/pgxulid
+
+go 1.25.4
+
+require (
+	github.com/jackc/pgx/v5 v5.11.0
+	github.com/kamalshkeir/ulid v1.0.0
+)
+
+replace github.com/kamalshkeir/ulid => ../
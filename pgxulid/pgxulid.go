@@ -0,0 +1,89 @@
+// Package pgxulid registers ULID support with pgx v5, mapping ulid.ULID
+// natively to the Postgres uuid type in binary wire format and []ulid.ULID
+// to uuid[] arrays.
+//
+// Register the types on every new connection, for instance with
+// pgxpool.Config.AfterConnect:
+//
+//	cfg.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+//		pgxulid.Register(conn.TypeMap())
+//		return nil
+//	}
+//
+// Text columns need no registration: ulid.ULID already implements
+// driver.Valuer and sql.Scanner with its canonical string form.
+package pgxulid
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kamalshkeir/ulid"
+)
+
+// Register registers the uuid and uuid[] types using Codec on m, and makes
+// them the default types for ulid.ULID and []ulid.ULID values.
+func Register(m *pgtype.Map) {
+	t := &pgtype.Type{Name: "uuid", OID: pgtype.UUIDOID, Codec: Codec{}}
+	m.RegisterType(t)
+	m.RegisterType(&pgtype.Type{Name: "_uuid", OID: pgtype.UUIDArrayOID, Codec: &pgtype.ArrayCodec{ElementType: t}})
+	m.RegisterDefaultPgType(ulid.ULID{}, "uuid")
+	m.RegisterDefaultPgType([]ulid.ULID{}, "_uuid")
+}
+
+// Codec is a pgtype.Codec for the Postgres uuid type which, on top of
+// everything pgtype.UUIDCodec supports, encodes ulid.ULID values and scans
+// into *ulid.ULID targets. A NULL scans to the zero ULID.
+type Codec struct {
+	pgtype.UUIDCodec
+}
+
+// PlanEncode implements pgtype.Codec.
+func (c Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	if _, ok := value.(ulid.ULID); ok {
+		if next := c.UUIDCodec.PlanEncode(m, oid, format, pgtype.UUID{}); next != nil {
+			return encodePlan{next: next}
+		}
+		return nil
+	}
+	return c.UUIDCodec.PlanEncode(m, oid, format, value)
+}
+
+// PlanScan implements pgtype.Codec.
+func (c Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	if _, ok := target.(*ulid.ULID); ok {
+		if next := c.UUIDCodec.PlanScan(m, oid, format, &uuidScanner{}); next != nil {
+			return scanPlan{next: next}
+		}
+		return nil
+	}
+	return c.UUIDCodec.PlanScan(m, oid, format, target)
+}
+
+type encodePlan struct {
+	next pgtype.EncodePlan
+}
+
+func (p encodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	return p.next.Encode(pgtype.UUID{Bytes: value.(ulid.ULID), Valid: true}, buf)
+}
+
+type scanPlan struct {
+	next pgtype.ScanPlan
+}
+
+func (p scanPlan) Scan(src []byte, target any) error {
+	return p.next.Scan(src, &uuidScanner{dst: target.(*ulid.ULID)})
+}
+
+// uuidScanner adapts a *ulid.ULID to pgtype.UUIDScanner.
+type uuidScanner struct {
+	dst *ulid.ULID
+}
+
+func (s *uuidScanner) ScanUUID(v pgtype.UUID) error {
+	if !v.Valid {
+		*s.dst = ulid.ULID{}
+		return nil
+	}
+	*s.dst = v.Bytes
+	return nil
+}
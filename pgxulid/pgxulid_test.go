@@ -0,0 +1,73 @@
+package pgxulid
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestCodec(t *testing.T) {
+	m := pgtype.NewMap()
+	Register(m)
+
+	id := ulid.Make()
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		buf, err := m.Encode(pgtype.UUIDOID, format, id, nil)
+		if err != nil {
+			t.Fatalf("Encode(format %d) error = %v", format, err)
+		}
+		if format == pgtype.BinaryFormatCode && len(buf) != ulid.RawSize {
+			t.Errorf("Encode(binary) length = %v, want %v", len(buf), ulid.RawSize)
+		}
+
+		var got ulid.ULID
+		if err := m.Scan(pgtype.UUIDOID, format, buf, &got); err != nil {
+			t.Fatalf("Scan(format %d) error = %v", format, err)
+		}
+		if got != id {
+			t.Errorf("Scan(Encode(format %d)) = %v, want %v", format, got, id)
+		}
+	}
+
+	// NULL scans to the zero ULID
+	got := ulid.Make()
+	if err := m.Scan(pgtype.UUIDOID, pgtype.BinaryFormatCode, nil, &got); err != nil {
+		t.Fatalf("Scan(NULL) error = %v", err)
+	}
+	if !got.IsZero() {
+		t.Errorf("Scan(NULL) = %v, want zero", got)
+	}
+
+	// The uuid text form is interoperable with pgtype.UUID
+	buf, _ := m.Encode(pgtype.UUIDOID, pgtype.TextFormatCode, id, nil)
+	var u pgtype.UUID
+	if err := m.Scan(pgtype.UUIDOID, pgtype.TextFormatCode, buf, &u); err != nil {
+		t.Fatalf("Scan(pgtype.UUID) error = %v", err)
+	}
+	if u.Bytes != [16]byte(id) {
+		t.Errorf("Scan(pgtype.UUID) = %x, want %x", u.Bytes, id)
+	}
+}
+
+func TestCodecArray(t *testing.T) {
+	m := pgtype.NewMap()
+	Register(m)
+
+	ids := []ulid.ULID{ulid.Make(), ulid.Make(), ulid.Make()}
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		buf, err := m.Encode(pgtype.UUIDArrayOID, format, ids, nil)
+		if err != nil {
+			t.Fatalf("Encode(format %d) error = %v", format, err)
+		}
+
+		var got []ulid.ULID
+		if err := m.Scan(pgtype.UUIDArrayOID, format, buf, &got); err != nil {
+			t.Fatalf("Scan(format %d) error = %v", format, err)
+		}
+		if !slices.Equal(got, ids) {
+			t.Errorf("Scan(Encode(format %d)) = %v, want %v", format, got, ids)
+		}
+	}
+}
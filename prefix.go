@@ -0,0 +1,28 @@
+package ulid
+
+// Prefix returns the first n characters of the canonical encoding of id,
+// n being clamped to [0, EncodedSize]. Only the returned prefix is
+// allocated.
+func (id ULID) Prefix(n int) string {
+	a := id.StringArray()
+	return string(a[:max(0, min(n, EncodedSize))])
+}
+
+// HasPrefix returns true if the canonical encoding of id starts with s,
+// ignoring case. It does not allocate.
+func (id ULID) HasPrefix(s string) bool {
+	if len(s) > EncodedSize {
+		return false
+	}
+	a := id.StringArray()
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if c != a[i] {
+			return false
+		}
+	}
+	return true
+}
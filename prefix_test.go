@@ -0,0 +1,50 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestPrefix(t *testing.T) {
+	id := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{-1, ""},
+		{0, ""},
+		{3, "01A"},
+		{26, "01AN4Z07BY79KA1307SR9X4MV3"},
+		{30, "01AN4Z07BY79KA1307SR9X4MV3"},
+	}
+	for _, tt := range tests {
+		if got := id.Prefix(tt.n); got != tt.want {
+			t.Errorf("Prefix(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestHasPrefix(t *testing.T) {
+	id := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{"", true},
+		{"01AN", true},
+		{"01an4z", true},
+		{"01AN4Z07BY79KA1307SR9X4MV3", true},
+		{"01AM", false},
+		{"01AN4Z07BY79KA1307SR9X4MV3X", false},
+	}
+	for _, tt := range tests {
+		if got := id.HasPrefix(tt.s); got != tt.want {
+			t.Errorf("HasPrefix(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+	}
+
+	if n := testing.AllocsPerRun(100, func() { id.HasPrefix("01AN") }); n != 0 {
+		t.Errorf("HasPrefix() allocs = %v, want 0", n)
+	}
+}
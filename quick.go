@@ -0,0 +1,25 @@
+package ulid
+
+import (
+	"math/rand"
+	"reflect"
+)
+
+// Generate implements the testing/quick.Generator interface, returning a
+// ULID with a random timestamp and entropy. One in eight ULIDs has a zero
+// or maximal entropy, to exercise the edges of monotonic increments.
+func (ULID) Generate(r *rand.Rand, size int) reflect.Value {
+	var id ULID
+	_ = id.SetTime(uint64(r.Int63n(int64(MaxTime) + 1)))
+
+	switch r.Intn(16) {
+	case 0: // entropy nulle
+	case 1:
+		for i := 6; i < RawSize; i++ {
+			id[i] = 0xFF
+		}
+	default:
+		r.Read(id[6:])
+	}
+	return reflect.ValueOf(id)
+}
@@ -0,0 +1,33 @@
+package ulid
+
+import (
+	"testing"
+	"testing/quick"
+)
+
+func TestQuickGenerate(t *testing.T) {
+	roundTrip := func(id ULID) bool {
+		got, err := ParseStrict(id.String())
+		return err == nil && got == id
+	}
+	if err := quick.Check(roundTrip, nil); err != nil {
+		t.Error(err)
+	}
+
+	order := func(a, b ULID) bool {
+		return a.Compare(b) == compareStrings(a.String(), b.String())
+	}
+	if err := quick.Check(order, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func compareStrings(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,75 @@
+package ulid
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is wrapped by the *RateLimitError returned when a
+// Generator exceeds the rate set with WithRateLimit.
+var ErrRateLimited = errors.New("ulid: generator rate limit exceeded")
+
+// RateLimitError is returned by a rate limited Generator out of tokens.
+type RateLimitError struct {
+	// RetryAfter is the time until the next token is available
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%v, retry after %v", ErrRateLimited, e.RetryAfter)
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return ErrRateLimited
+}
+
+// WithRateLimit limits the Generator to perSecond ULIDs per second on
+// average, with bursts of up to burst ULIDs, so that ID issuance exposed to
+// clients cannot be used to flood downstream systems. Generating beyond the
+// limit returns a *RateLimitError. The limit is measured with the clock of
+// the Generator.
+//
+// A perSecond <= 0 disables the limit, and burst is at least 1.
+func WithRateLimit(perSecond float64, burst int) Option {
+	return func(g *Generator) {
+		if perSecond <= 0 {
+			g.limiter = nil
+			return
+		}
+		b := float64(max(burst, 1))
+		g.limiter = &limiter{rate: perSecond, burst: b, tokens: b}
+	}
+}
+
+// limiter est un seau à jetons.
+type limiter struct {
+	mu     sync.Mutex
+	rate   float64 // jetons par seconde
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// take consumes a token, or returns a *RateLimitError if none is left.
+func (l *limiter) take(now time.Time) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if !l.last.IsZero() {
+		if elapsed := now.Sub(l.last); elapsed > 0 {
+			l.tokens = min(l.burst, l.tokens+elapsed.Seconds()*l.rate)
+		}
+	}
+	if now.After(l.last) {
+		l.last = now
+	}
+
+	if l.tokens < 1 {
+		wait := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		return &RateLimitError{RetryAfter: wait}
+	}
+	l.tokens--
+	return nil
+}
@@ -0,0 +1,62 @@
+package ulid
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestGeneratorRateLimit(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	g := NewGenerator(
+		WithRateLimit(10, 3),
+		WithClock(ClockFunc(func() time.Time { return now })),
+	)
+
+	for i := 0; i < 3; i++ {
+		if _, err := g.New(); err != nil {
+			t.Fatalf("New() #%d error = %v", i, err)
+		}
+	}
+
+	_, err := g.New()
+	var rle *RateLimitError
+	if !errors.As(err, &rle) || !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("New() error = %v, want *RateLimitError", err)
+	}
+	if rle.RetryAfter != 100*time.Millisecond {
+		t.Errorf("RetryAfter = %v, want 100ms", rle.RetryAfter)
+	}
+
+	now = now.Add(100 * time.Millisecond)
+	if _, err := g.New(); err != nil {
+		t.Errorf("New() after refill error = %v", err)
+	}
+	if _, err := g.New(); err == nil {
+		t.Error("New() should be limited again")
+	}
+
+	// The bucket does not grow beyond the burst
+	now = now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		if _, err := g.New(); err != nil {
+			t.Fatalf("New() #%d error = %v", i, err)
+		}
+	}
+	if _, err := g.New(); err == nil {
+		t.Error("New() should be limited after the burst")
+	}
+
+	if g.Metrics().Generated() != 7 {
+		t.Errorf("Generated() = %d, want 7", g.Metrics().Generated())
+	}
+}
+
+func TestGeneratorRateLimitDisabled(t *testing.T) {
+	g := NewGenerator(WithRateLimit(0, 0))
+	for i := 0; i < 100; i++ {
+		if _, err := g.New(); err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+	}
+}
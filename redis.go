@@ -0,0 +1,64 @@
+package ulid
+
+import "fmt"
+
+// Redis commands receive ULIDs in their 16 bytes binary form, halving the
+// memory used by large sets compared to the text form:
+//
+//   - redigo uses RedisArg and RedisScan
+//   - go-redis uses MarshalBinary and UnmarshalBinary, so IDs can be passed
+//     directly as arguments and scanned with Cmd.Scan
+
+// RedisArg implements the redigo redis.Argument interface, returning the
+// binary form of the ULID.
+func (id ULID) RedisArg() interface{} {
+	return id.Bytes()
+}
+
+// RedisScan implements the redigo redis.Scanner interface. It supports the
+// binary and the text forms of ULIDs, so values written before switching to
+// the binary form can still be read. A nil reply sets the zero ULID.
+func (id *ULID) RedisScan(src interface{}) error {
+	var b []byte
+	switch x := src.(type) {
+	case nil:
+		*id = ULID{}
+		return nil
+	case []byte:
+		b = x
+	case string:
+		b = []byte(x)
+	default:
+		return ErrScanValue
+	}
+	return id.unmarshalRedis(b)
+}
+
+func (id *ULID) unmarshalRedis(b []byte) error {
+	if len(b) == EncodedSize {
+		return id.UnmarshalText(b)
+	}
+	return id.UnmarshalBinary(b)
+}
+
+// RedisArgs returns the binary forms of ids as command arguments, e.g. for
+// SADD or ZADD with go-redis or redigo.
+func RedisArgs(ids []ULID) []interface{} {
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		args[i] = id.Bytes()
+	}
+	return args
+}
+
+// FromRedisStrings returns the ULIDs of a string slice reply, as returned by
+// go-redis for SMEMBERS or LRANGE. Values may be in binary or text form.
+func FromRedisStrings(vals []string) ([]ULID, error) {
+	ids := make([]ULID, len(vals))
+	for i, v := range vals {
+		if err := ids[i].unmarshalRedis([]byte(v)); err != nil {
+			return nil, fmt.Errorf("ulid: redis value %d: %w", i, err)
+		}
+	}
+	return ids, nil
+}
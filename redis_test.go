@@ -0,0 +1,61 @@
+package ulid
+
+import (
+	"encoding"
+	"errors"
+	"testing"
+)
+
+func TestRedis(t *testing.T) {
+	id := Make()
+
+	b, ok := id.RedisArg().([]byte)
+	if !ok || len(b) != RawSize {
+		t.Fatalf("RedisArg() = %v, want 16 bytes", id.RedisArg())
+	}
+
+	for _, src := range []interface{}{b, string(b), id.String(), []byte(id.String())} {
+		var got ULID
+		if err := got.RedisScan(src); err != nil || got != id {
+			t.Errorf("RedisScan(%v) = %v, %v, want %v", src, got, err, id)
+		}
+	}
+
+	got := id
+	if err := got.RedisScan(nil); err != nil || !got.IsZero() {
+		t.Errorf("RedisScan(nil) = %v, %v, want zero", got, err)
+	}
+	if err := got.RedisScan(int64(1)); err != ErrScanValue {
+		t.Errorf("RedisScan(int64) error = %v, want %v", err, ErrScanValue)
+	}
+	if err := got.RedisScan([]byte("short")); err != ErrDataSize {
+		t.Errorf("RedisScan(short) error = %v, want %v", err, ErrDataSize)
+	}
+
+	// go-redis relies on these interfaces
+	var _ encoding.BinaryMarshaler = id
+	var _ encoding.BinaryUnmarshaler = &id
+}
+
+func TestRedisArgs(t *testing.T) {
+	ids := []ULID{Make(), Make()}
+	args := RedisArgs(ids)
+
+	vals := make([]string, len(args))
+	for i, a := range args {
+		vals[i] = string(a.([]byte))
+	}
+	vals = append(vals, ids[0].String())
+
+	got, err := FromRedisStrings(vals)
+	if err != nil {
+		t.Fatalf("FromRedisStrings() error = %v", err)
+	}
+	if len(got) != 3 || got[0] != ids[0] || got[1] != ids[1] || got[2] != ids[0] {
+		t.Errorf("FromRedisStrings() = %v", got)
+	}
+
+	if _, err := FromRedisStrings([]string{"bad"}); !errors.Is(err, ErrDataSize) {
+		t.Errorf("FromRedisStrings() error = %v, want %v", err, ErrDataSize)
+	}
+}
@@ -0,0 +1,136 @@
+package ulid
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"slices"
+	"strconv"
+)
+
+// DefaultReplicas is the number of points a node of weight 1 gets on a
+// Ring when NewRing is given replicas <= 0.
+const DefaultReplicas = 128
+
+// Ring is a consistent hashing ring of named nodes. Each ULID is owned by
+// the node of the first point following the last 8 bytes of its entropy,
+// so adding or removing a node only remaps the IDs of that node. Like
+// Shard, the placement is stable across processes and platforms.
+//
+// A Ring is NOT safe for concurrent use; build it once, then share it
+// read-only between goroutines.
+type Ring struct {
+	replicas int
+	weights  map[string]int
+	points   []ringPoint
+}
+
+type ringPoint struct {
+	pos  uint64
+	node string
+}
+
+// NewRing returns an empty Ring placing replicas points per unit of weight.
+func NewRing(replicas int) *Ring {
+	if replicas <= 0 {
+		replicas = DefaultReplicas
+	}
+	return &Ring{replicas: replicas, weights: make(map[string]int)}
+}
+
+// Add places node on the ring with the given weight, replacing its
+// previous weight if any. A node of weight 2 owns about twice as many IDs
+// as a node of weight 1. A weight <= 0 removes the node.
+func (r *Ring) Add(node string, weight int) {
+	if weight <= 0 {
+		r.Remove(node)
+		return
+	}
+	r.weights[node] = weight
+	r.build()
+}
+
+// Remove takes node off the ring.
+func (r *Ring) Remove(node string) {
+	if _, ok := r.weights[node]; !ok {
+		return
+	}
+	delete(r.weights, node)
+	r.build()
+}
+
+// Len returns the number of nodes on the ring.
+func (r *Ring) Len() int {
+	return len(r.weights)
+}
+
+// Nodes returns the names of the nodes on the ring, sorted.
+func (r *Ring) Nodes() []string {
+	nodes := make([]string, 0, len(r.weights))
+	for node := range r.weights {
+		nodes = append(nodes, node)
+	}
+	slices.Sort(nodes)
+	return nodes
+}
+
+// Node returns the node owning id, or "" if the ring is empty.
+func (r *Ring) Node(id ULID) string {
+	if len(r.points) == 0 {
+		return ""
+	}
+	pos := binary.BigEndian.Uint64(id[8:])
+	i, _ := slices.BinarySearchFunc(r.points, pos, func(p ringPoint, pos uint64) int {
+		switch {
+		case p.pos < pos:
+			return -1
+		case p.pos > pos:
+			return 1
+		}
+		return 0
+	})
+	if i == len(r.points) {
+		i = 0
+	}
+	return r.points[i].node
+}
+
+func (r *Ring) build() {
+	r.points = r.points[:0]
+	for node, w := range r.weights {
+		for i := 0; i < w*r.replicas; i++ {
+			r.points = append(r.points, ringPoint{ringPos(node, i), node})
+		}
+	}
+	// Le nom départage les collisions pour que l'ordre ne dépende pas de la map
+	slices.SortFunc(r.points, func(a, b ringPoint) int {
+		switch {
+		case a.pos < b.pos:
+			return -1
+		case a.pos > b.pos:
+			return 1
+		}
+		switch {
+		case a.node < b.node:
+			return -1
+		case a.node > b.node:
+			return 1
+		}
+		return 0
+	})
+}
+
+// ringPos returns the position of the i-th point of node: FNV-1a spreads
+// poorly on short inputs, so its result goes through the splitmix64
+// finalizer.
+func ringPos(node string, i int) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(node))
+	h.Write(strconv.AppendInt([]byte{'#'}, int64(i), 10))
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,58 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestRing(t *testing.T) {
+	r := NewRing(0)
+	if got := r.Node(Make()); got != "" {
+		t.Errorf("Node() on empty ring = %q, want empty", got)
+	}
+
+	r.Add("a", 1)
+	r.Add("b", 1)
+	r.Add("c", 2)
+	if r.Len() != 3 {
+		t.Errorf("Len() = %v, want 3", r.Len())
+	}
+
+	ids := make([]ULID, 8000)
+	owners := make([]string, len(ids))
+	counts := map[string]int{}
+	for i := range ids {
+		ids[i] = Make()
+		owners[i] = r.Node(ids[i])
+		counts[owners[i]]++
+	}
+	for node, want := range map[string]int{"a": 2000, "b": 2000, "c": 4000} {
+		if c := counts[node]; c < want*7/10 || c > want*13/10 {
+			t.Errorf("node %s got %d IDs, want about %d", node, c, want)
+		}
+	}
+
+	// Only the IDs of the removed node move
+	r.Remove("a")
+	for i, id := range ids {
+		got := r.Node(id)
+		if owners[i] != "a" && got != owners[i] {
+			t.Fatalf("Node(%v) = %q after removing a, want %q", id, got, owners[i])
+		}
+		if got == "a" {
+			t.Fatalf("Node(%v) = a after removing it", id)
+		}
+	}
+	if nodes := r.Nodes(); len(nodes) != 2 || nodes[0] != "b" || nodes[1] != "c" {
+		t.Errorf("Nodes() = %v, want [b c]", nodes)
+	}
+
+	// Placement does not depend on the order nodes were added in
+	r2 := NewRing(0)
+	r2.Add("c", 2)
+	r2.Add("b", 1)
+	for _, id := range ids[:100] {
+		if r.Node(id) != r2.Node(id) {
+			t.Fatalf("Node(%v) depends on insertion order", id)
+		}
+	}
+}
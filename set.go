@@ -0,0 +1,104 @@
+package ulid
+
+import (
+	"iter"
+	"slices"
+)
+
+// Set is a collection of unique ULIDs. The zero value is an empty set
+// ready to use.
+//
+// A Set is NOT safe for concurrent use.
+type Set struct {
+	m map[ULID]struct{}
+}
+
+// NewSet returns a Set containing the given ULIDs.
+func NewSet(ids ...ULID) *Set {
+	s := &Set{m: make(map[ULID]struct{}, len(ids))}
+	for _, id := range ids {
+		s.m[id] = struct{}{}
+	}
+	return s
+}
+
+// Len returns the number of ULIDs in the set.
+func (s *Set) Len() int {
+	return len(s.m)
+}
+
+// Add inserts id into the set. It returns true if id was not already present.
+func (s *Set) Add(id ULID) bool {
+	if s.m == nil {
+		s.m = make(map[ULID]struct{})
+	}
+	if _, ok := s.m[id]; ok {
+		return false
+	}
+	s.m[id] = struct{}{}
+	return true
+}
+
+// Contains returns true if id is in the set.
+func (s *Set) Contains(id ULID) bool {
+	_, ok := s.m[id]
+	return ok
+}
+
+// Remove deletes id from the set. It returns true if id was present.
+func (s *Set) Remove(id ULID) bool {
+	if _, ok := s.m[id]; !ok {
+		return false
+	}
+	delete(s.m, id)
+	return true
+}
+
+// Union returns a new set holding the ULIDs present in s or other.
+func (s *Set) Union(other *Set) *Set {
+	res := &Set{m: make(map[ULID]struct{}, len(s.m)+len(other.m))}
+	for id := range s.m {
+		res.m[id] = struct{}{}
+	}
+	for id := range other.m {
+		res.m[id] = struct{}{}
+	}
+	return res
+}
+
+// Intersect returns a new set holding the ULIDs present in both s and other.
+func (s *Set) Intersect(other *Set) *Set {
+	small, big := s, other
+	if len(big.m) < len(small.m) {
+		small, big = big, small
+	}
+	res := &Set{m: make(map[ULID]struct{})}
+	for id := range small.m {
+		if _, ok := big.m[id]; ok {
+			res.m[id] = struct{}{}
+		}
+	}
+	return res
+}
+
+// Slice returns the ULIDs of the set in ascending order.
+func (s *Set) Slice() []ULID {
+	ids := make([]ULID, 0, len(s.m))
+	for id := range s.m {
+		ids = append(ids, id)
+	}
+	slices.SortFunc(ids, ULID.Compare)
+	return ids
+}
+
+// All returns an iterator over the ULIDs of the set in ascending order.
+// The iteration works on a snapshot taken when the iterator starts.
+func (s *Set) All() iter.Seq[ULID] {
+	return func(yield func(ULID) bool) {
+		for _, id := range s.Slice() {
+			if !yield(id) {
+				return
+			}
+		}
+	}
+}
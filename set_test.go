@@ -0,0 +1,59 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	var s Set
+	id1 := MustNew(1, nil)
+	id2 := MustNew(2, nil)
+	id3 := MustNew(3, nil)
+
+	if !s.Add(id2) || !s.Add(id1) {
+		t.Fatal("Add() should return true for new ULIDs")
+	}
+	if s.Add(id1) {
+		t.Error("Add() should return false for duplicate ULID")
+	}
+	if s.Len() != 2 {
+		t.Errorf("Len() = %v, want 2", s.Len())
+	}
+	if !s.Contains(id1) || s.Contains(id3) {
+		t.Error("Contains() returned wrong result")
+	}
+
+	var got []ULID
+	for id := range s.All() {
+		got = append(got, id)
+	}
+	if len(got) != 2 || got[0] != id1 || got[1] != id2 {
+		t.Errorf("All() = %v, want sorted [%v %v]", got, id1, id2)
+	}
+
+	if !s.Remove(id1) || s.Remove(id1) {
+		t.Error("Remove() returned wrong result")
+	}
+	if s.Contains(id1) {
+		t.Error("Contains() should return false after Remove()")
+	}
+}
+
+func TestSetUnionIntersect(t *testing.T) {
+	id1 := MustNew(1, nil)
+	id2 := MustNew(2, nil)
+	id3 := MustNew(3, nil)
+
+	a := NewSet(id1, id2)
+	b := NewSet(id2, id3)
+
+	u := a.Union(b).Slice()
+	if len(u) != 3 || u[0] != id1 || u[1] != id2 || u[2] != id3 {
+		t.Errorf("Union() = %v", u)
+	}
+
+	i := a.Intersect(b).Slice()
+	if len(i) != 1 || i[0] != id2 {
+		t.Errorf("Intersect() = %v", i)
+	}
+}
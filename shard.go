@@ -0,0 +1,40 @@
+package ulid
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math/bits"
+)
+
+// hashSeed is the seed used by HashDefault, random for each process.
+var hashSeed = maphash.MakeSeed()
+
+// Shard maps id to one of n shards, returning a value in [0, n).
+//
+// Only the last 8 bytes of entropy are used, so IDs are spread uniformly
+// regardless of their timestamp. The result is stable: for a given ULID and
+// n it never changes across processes, platforms or versions of this
+// package, which makes it suitable as a partition key. Changing n remaps
+// most IDs; use a consistent hashing scheme if shards come and go.
+//
+// Shard panics if n <= 0.
+func (id ULID) Shard(n int) int {
+	if n <= 0 {
+		panic("ulid: shard count must be positive")
+	}
+	hi, _ := bits.Mul64(binary.BigEndian.Uint64(id[8:]), uint64(n))
+	return int(hi)
+}
+
+// Hash returns the hash of id with the given seed, as computed by the
+// hash/maphash package. Unlike Shard, the result depends on the seed and on
+// the Go version, so it must not be persisted or shared between processes.
+func (id ULID) Hash(seed maphash.Seed) uint64 {
+	return maphash.Comparable(seed, id)
+}
+
+// HashDefault is like Hash, with a seed chosen randomly when the process
+// starts.
+func (id ULID) HashDefault() uint64 {
+	return id.Hash(hashSeed)
+}
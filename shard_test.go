@@ -0,0 +1,57 @@
+package ulid
+
+import (
+	"hash/maphash"
+	"testing"
+)
+
+func TestShard(t *testing.T) {
+	const n = 8
+	counts := make([]int, n)
+	for i := 0; i < 8000; i++ {
+		id := Make()
+		s := id.Shard(n)
+		if s < 0 || s >= n {
+			t.Fatalf("Shard(%d) = %v, out of range", n, s)
+		}
+		if id.Shard(n) != s {
+			t.Fatal("Shard() is not stable")
+		}
+		counts[s]++
+	}
+	for i, c := range counts {
+		if c < 700 || c > 1300 {
+			t.Errorf("shard %d got %d IDs, want about 1000", i, c)
+		}
+	}
+
+	// Pinned value: the mapping must never change
+	id := ULID{8: 0x80}
+	if got := id.Shard(2); got != 1 {
+		t.Errorf("Shard(2) = %v, want 1", got)
+	}
+	if got := Nil.Shard(3); got != 0 {
+		t.Errorf("Nil.Shard(3) = %v, want 0", got)
+	}
+}
+
+func TestHash(t *testing.T) {
+	seed := maphash.MakeSeed()
+	id := Make()
+
+	if id.Hash(seed) != id.Hash(seed) {
+		t.Error("Hash() is not stable for a given seed")
+	}
+	if id.HashDefault() != id.HashDefault() {
+		t.Error("HashDefault() is not stable")
+	}
+	if other := Make(); id.Hash(seed) == other.Hash(seed) {
+		t.Errorf("Hash() of %v and %v collide", id, other)
+	}
+	if id.Hash(seed) == id.Hash(maphash.MakeSeed()) {
+		t.Error("Hash() does not depend on the seed")
+	}
+	if n := testing.AllocsPerRun(100, func() { _ = id.Hash(seed) }); n != 0 {
+		t.Errorf("Hash() allocs = %v, want 0", n)
+	}
+}
@@ -0,0 +1,185 @@
+package ulid
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"io"
+	"time"
+)
+
+const (
+	// ShortEncodedSize is the length of a text encoded Short ID
+	ShortEncodedSize = 13
+
+	// ShortEpoch is the Unix time in milliseconds from which Short IDs count
+	// time (2020-01-01T00:00:00Z), as in TSID
+	ShortEpoch = 1577836800000
+
+	// ShortBits is the number of low bits of a Short ID available for
+	// randomness, node or counter values
+	ShortBits = 22
+
+	shortMaxTime = 1<<(64-ShortBits) - 1
+)
+
+// Short is a compact 64-bit time-sortable ID compatible with TSID and
+// Snowflake sized BIGINT columns. The upper 42 bits hold the milliseconds
+// elapsed since ShortEpoch and the lower 22 bits are free for random, node
+// or counter values.
+//
+// Short IDs are encoded as 13 characters of Crockford's Base32.
+type Short uint64
+
+// NewShort returns a Short ID with the given Unix milliseconds timestamp and
+// its low bits read from the optional entropy source, defaulting to
+// crypto/rand or the source set with SetEntropySource.
+//
+// ErrBigTime is returned when ms is before ShortEpoch or too large to fit.
+func NewShort(ms uint64, entropy io.Reader) (Short, error) {
+	var b [4]byte
+	var err error
+	if entropy == nil {
+		_, err = readRandom(b[:])
+	} else {
+		_, err = io.ReadFull(entropy, b[:])
+	}
+	if err != nil {
+		return 0, err
+	}
+	return ShortFromParts(ms, binary.BigEndian.Uint32(b[:]))
+}
+
+// ShortFromParts returns a Short ID with the given Unix milliseconds
+// timestamp and low bits. Only the lower ShortBits bits of low are kept,
+// which lets callers pack their own node and counter values.
+//
+// ErrBigTime is returned when ms is before ShortEpoch or too large to fit.
+func ShortFromParts(ms uint64, low uint32) (Short, error) {
+	if ms < ShortEpoch || ms-ShortEpoch > shortMaxTime {
+		return 0, ErrBigTime
+	}
+	return Short((ms-ShortEpoch)<<ShortBits | uint64(low)&(1<<ShortBits-1)), nil
+}
+
+// MakeShort returns a Short ID with the current time and random low bits.
+// Like with Make, a failure to read the entropy is reported to the hook set
+// with SetEntropyFailureHook and leaves the low bits zero.
+func MakeShort() Short {
+	var b [4]byte
+	if _, err := readRandom(b[:]); err != nil {
+		entropyFailed(err)
+	}
+	s, _ := ShortFromParts(Timestamp(time.Now()), binary.BigEndian.Uint32(b[:]))
+	return s
+}
+
+// ParseShort parses an encoded Short ID.
+func ParseShort(s string) (Short, error) {
+	var id Short
+	return id, id.UnmarshalText([]byte(s))
+}
+
+// Time returns the Unix time in milliseconds encoded in the Short ID.
+func (s Short) Time() uint64 {
+	return uint64(s)>>ShortBits + ShortEpoch
+}
+
+// Bits returns the low ShortBits bits of the Short ID.
+func (s Short) Bits() uint32 {
+	return uint32(s) & (1<<ShortBits - 1)
+}
+
+// Int64 returns the Short ID as a signed integer for BIGINT columns.
+func (s Short) Int64() int64 {
+	return int64(s)
+}
+
+// ULID converts s to a ULID with the same timestamp. The low bits of s
+// become the leading entropy bits and the remaining entropy is zero.
+func (s Short) ULID() ULID {
+	var id ULID
+	_ = id.SetTime(s.Time())
+	binary.BigEndian.PutUint32(id[6:], s.Bits()<<(32-ShortBits))
+	return id
+}
+
+// Short converts id to a Short ID, keeping its timestamp and the leading
+// ShortBits bits of its entropy. ErrBigTime is returned if the timestamp of
+// id cannot be represented.
+func (id ULID) Short() (Short, error) {
+	return ShortFromParts(id.Time(), binary.BigEndian.Uint32(id[6:])>>(32-ShortBits))
+}
+
+// String returns the 13 character encoding of the Short ID.
+func (s Short) String() string {
+	var buf [ShortEncodedSize]byte
+	_ = s.MarshalTextTo(buf[:])
+	return string(buf[:])
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (s Short) MarshalText() ([]byte, error) {
+	buf := make([]byte, ShortEncodedSize)
+	return buf, s.MarshalTextTo(buf)
+}
+
+// MarshalTextTo writes the Short ID as a string to the given buffer.
+// ErrBufferSize is returned when len(dst) != ShortEncodedSize.
+func (s Short) MarshalTextTo(dst []byte) error {
+	if len(dst) != ShortEncodedSize {
+		return ErrBufferSize
+	}
+	v := uint64(s)
+	for i := ShortEncodedSize - 1; i >= 0; i-- {
+		dst[i] = enc[v&31]
+		v >>= 5
+	}
+	return nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// ErrOverflow is returned if the first character is larger than F.
+func (s *Short) UnmarshalText(v []byte) error {
+	if len(v) != ShortEncodedSize {
+		return ErrDataSize
+	}
+	var n uint64
+	for _, c := range v {
+		d := dec[c]
+		if d == 0xFF {
+			return ErrInvalidCharacters
+		}
+		n = n<<5 | uint64(d)
+	}
+	// 13 caractères = 65 bits, le premier ne doit utiliser que 4 bits
+	if dec[v[0]] > 15 {
+		return ErrOverflow
+	}
+	*s = Short(n)
+	return nil
+}
+
+// Scan implements the sql.Scanner interface. It supports scanning an
+// integer, a string or a byte slice. A NULL value sets the zero Short,
+// like ULID.Scan.
+func (s *Short) Scan(src interface{}) error {
+	switch x := src.(type) {
+	case nil:
+		*s = 0
+		return nil
+	case int64:
+		*s = Short(x)
+		return nil
+	case string:
+		return s.UnmarshalText([]byte(x))
+	case []byte:
+		return s.UnmarshalText(x)
+	}
+	return ErrScanValue
+}
+
+// Value implements the sql/driver.Valuer interface, returning the Short ID
+// as an int64.
+func (s Short) Value() (driver.Value, error) {
+	return int64(s), nil
+}
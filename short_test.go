@@ -0,0 +1,78 @@
+package ulid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestShort(t *testing.T) {
+	now := Timestamp(time.Now())
+	s, err := ShortFromParts(now, 42)
+	if err != nil {
+		t.Fatalf("ShortFromParts() error = %v", err)
+	}
+	if s.Time() != now || s.Bits() != 42 {
+		t.Errorf("Time(), Bits() = %v, %v, want %v, 42", s.Time(), s.Bits(), now)
+	}
+
+	str := s.String()
+	if len(str) != ShortEncodedSize {
+		t.Errorf("String() length = %v, want %v", len(str), ShortEncodedSize)
+	}
+	parsed, err := ParseShort(str)
+	if err != nil {
+		t.Fatalf("ParseShort() error = %v", err)
+	}
+	if parsed != s {
+		t.Errorf("ParseShort(String()) = %v, want %v", parsed, s)
+	}
+
+	if _, err := ShortFromParts(ShortEpoch-1, 0); err != ErrBigTime {
+		t.Errorf("ShortFromParts(before epoch) error = %v, want %v", err, ErrBigTime)
+	}
+	if _, err := ParseShort("G000000000000"); err != ErrOverflow {
+		t.Errorf("ParseShort(overflow) error = %v, want %v", err, ErrOverflow)
+	}
+}
+
+func TestShortOrdering(t *testing.T) {
+	a := MakeShort()
+	time.Sleep(2 * time.Millisecond)
+	b := MakeShort()
+	if a >= b || a.String() >= b.String() {
+		t.Error("Short IDs should be time ordered")
+	}
+}
+
+func TestShortConversion(t *testing.T) {
+	s := MakeShort()
+	id := s.ULID()
+	if id.Time() != s.Time() {
+		t.Errorf("ULID().Time() = %v, want %v", id.Time(), s.Time())
+	}
+	back, err := id.Short()
+	if err != nil {
+		t.Fatalf("Short() error = %v", err)
+	}
+	if back != s {
+		t.Errorf("ULID().Short() = %v, want %v", back, s)
+	}
+}
+
+func TestShortSQL(t *testing.T) {
+	s := MakeShort()
+	v, err := s.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	var s2 Short
+	if err := s2.Scan(v); err != nil {
+		t.Fatalf("Scan() error = %v", err)
+	}
+	if s2 != s {
+		t.Errorf("Scan(Value()) = %v, want %v", s2, s)
+	}
+	if err := s2.Scan(nil); err != nil || s2 != 0 {
+		t.Errorf("Scan(nil) = %v, %v, want 0", s2, err)
+	}
+}
@@ -0,0 +1,158 @@
+package ulid
+
+import (
+	"iter"
+	"slices"
+	"time"
+)
+
+// Sort sorts ids in ascending order.
+func Sort(ids []ULID) {
+	slices.SortFunc(ids, ULID.Compare)
+}
+
+// Compare is the function form of ULID.Compare, for use with generic code
+// such as slices.SortFunc, slices.BinarySearchFunc or slices.MinFunc.
+func Compare(a, b ULID) int {
+	return a.Compare(b)
+}
+
+// Less reports whether a sorts before b, like a.Less(b).
+func Less(a, b ULID) bool {
+	return a.Compare(b) < 0
+}
+
+// MinOf returns the smallest of ids, or the zero ULID if there are none.
+func MinOf(ids ...ULID) ULID {
+	if len(ids) == 0 {
+		return ULID{}
+	}
+	return slices.MinFunc(ids, ULID.Compare)
+}
+
+// MaxOf returns the largest of ids, or the zero ULID if there are none.
+func MaxOf(ids ...ULID) ULID {
+	if len(ids) == 0 {
+		return ULID{}
+	}
+	return slices.MaxFunc(ids, ULID.Compare)
+}
+
+// Dedup sorts ids in place if needed and removes duplicate ULIDs, returning
+// the shortened slice. Already sorted input is detected and not re-sorted.
+func Dedup(ids []ULID) []ULID {
+	if !slices.IsSortedFunc(ids, ULID.Compare) {
+		Sort(ids)
+	}
+	return slices.Compact(ids)
+}
+
+// Union returns the sorted, deduplicated ULIDs present in a or b.
+// Both inputs must be sorted in ascending order.
+func Union(a, b []ULID) []ULID {
+	res := make([]ULID, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch a[i].Compare(b[j]) {
+		case -1:
+			res = appendUnique(res, a[i])
+			i++
+		case 1:
+			res = appendUnique(res, b[j])
+			j++
+		default:
+			res = appendUnique(res, a[i])
+			i++
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		res = appendUnique(res, a[i])
+	}
+	for ; j < len(b); j++ {
+		res = appendUnique(res, b[j])
+	}
+	return res
+}
+
+// Intersect returns the sorted, deduplicated ULIDs present in both a and b.
+// Both inputs must be sorted in ascending order.
+func Intersect(a, b []ULID) []ULID {
+	var res []ULID
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch a[i].Compare(b[j]) {
+		case -1:
+			i++
+		case 1:
+			j++
+		default:
+			res = appendUnique(res, a[i])
+			i++
+			j++
+		}
+	}
+	return res
+}
+
+// Diff returns the sorted, deduplicated ULIDs present in a but not in b.
+// Both inputs must be sorted in ascending order.
+func Diff(a, b []ULID) []ULID {
+	var res []ULID
+	i, j := 0, 0
+	for i < len(a) {
+		if j == len(b) {
+			res = appendUnique(res, a[i])
+			i++
+			continue
+		}
+		switch a[i].Compare(b[j]) {
+		case -1:
+			res = appendUnique(res, a[i])
+			i++
+		case 1:
+			j++
+		default:
+			i++
+		}
+	}
+	return res
+}
+
+func appendUnique(dst []ULID, id ULID) []ULID {
+	if len(dst) > 0 && dst[len(dst)-1] == id {
+		return dst
+	}
+	return append(dst, id)
+}
+
+// BucketBy walks the sorted slice ids and yields, for each time window of
+// width d, the start of the window and the sub-slice of ids created in it.
+// Sub-slices share the memory of ids. Empty windows are skipped, and with
+// unsorted input a new window starts each time the window changes.
+// A d <= 0 uses one window per millisecond.
+func BucketBy(ids []ULID, d time.Duration) iter.Seq2[time.Time, []ULID] {
+	w := uint64(d.Milliseconds())
+	if w == 0 {
+		w = 1
+	}
+	return func(yield func(time.Time, []ULID) bool) {
+		rest := ids
+		for len(rest) > 0 {
+			ms := rest[0].Time()
+			start := ms - ms%w
+			n := 1
+			for n < len(rest) {
+				ms = rest[n].Time()
+				if ms-ms%w != start {
+					break
+				}
+				n++
+			}
+			if !yield(Time(start), rest[:n:n]) {
+				return
+			}
+			rest = rest[n:]
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package ulid
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestDedup(t *testing.T) {
+	id1 := MustNew(1, nil)
+	id2 := MustNew(2, nil)
+	id3 := MustNew(3, nil)
+
+	got := Dedup([]ULID{id3, id1, id2, id1, id3})
+	want := []ULID{id1, id2, id3}
+	if !slices.Equal(got, want) {
+		t.Errorf("Dedup() = %v, want %v", got, want)
+	}
+
+	if got := Dedup(nil); len(got) != 0 {
+		t.Errorf("Dedup(nil) = %v, want empty", got)
+	}
+}
+
+func TestCompareFuncs(t *testing.T) {
+	id1 := MustNew(1, nil)
+	id2 := MustNew(2, nil)
+	id3 := MustNew(3, nil)
+
+	if Compare(id1, id2) != -1 || Compare(id2, id2) != 0 || !Less(id1, id2) || Less(id2, id1) {
+		t.Error("Compare() or Less() disagree with the methods")
+	}
+	if got := MinOf(id2, id3, id1); got != id1 {
+		t.Errorf("MinOf() = %v, want %v", got, id1)
+	}
+	if got := MaxOf(id2, id3, id1); got != id3 {
+		t.Errorf("MaxOf() = %v, want %v", got, id3)
+	}
+	if !MinOf().IsZero() || !MaxOf().IsZero() {
+		t.Error("MinOf() and MaxOf() without arguments should be zero")
+	}
+
+	ids := []ULID{id3, id1, id2}
+	slices.SortFunc(ids, Compare)
+	if i, ok := slices.BinarySearchFunc(ids, id2, Compare); !ok || i != 1 {
+		t.Errorf("BinarySearchFunc() = %d, %v, want 1, true", i, ok)
+	}
+}
+
+func TestUnionIntersectDiff(t *testing.T) {
+	id1 := MustNew(1, nil)
+	id2 := MustNew(2, nil)
+	id3 := MustNew(3, nil)
+	id4 := MustNew(4, nil)
+
+	a := []ULID{id1, id2, id2, id3}
+	b := []ULID{id2, id3, id4}
+
+	if got, want := Union(a, b), []ULID{id1, id2, id3, id4}; !slices.Equal(got, want) {
+		t.Errorf("Union() = %v, want %v", got, want)
+	}
+	if got, want := Intersect(a, b), []ULID{id2, id3}; !slices.Equal(got, want) {
+		t.Errorf("Intersect() = %v, want %v", got, want)
+	}
+	if got, want := Diff(a, b), []ULID{id1}; !slices.Equal(got, want) {
+		t.Errorf("Diff() = %v, want %v", got, want)
+	}
+	if got, want := Diff(b, a), []ULID{id4}; !slices.Equal(got, want) {
+		t.Errorf("Diff() = %v, want %v", got, want)
+	}
+}
+
+func TestBucketBy(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) ULID { return MakeWithTime(base.Add(d)) }
+	ids := []ULID{at(0), at(30 * time.Second), at(time.Minute), at(5 * time.Minute), at(5*time.Minute + 59*time.Second)}
+	Sort(ids)
+
+	var starts []time.Time
+	var sizes []int
+	for start, bucket := range BucketBy(ids, time.Minute) {
+		starts = append(starts, start)
+		sizes = append(sizes, len(bucket))
+		for _, id := range bucket {
+			if id.Before(start) || !id.Before(start.Add(time.Minute)) {
+				t.Errorf("%v not in window %v", Time(id.Time()), start)
+			}
+		}
+	}
+
+	wantStarts := []time.Time{base, base.Add(time.Minute), base.Add(5 * time.Minute)}
+	wantSizes := []int{2, 1, 2}
+	if !slices.EqualFunc(starts, wantStarts, time.Time.Equal) || !slices.Equal(sizes, wantSizes) {
+		t.Errorf("BucketBy() = %v %v, want %v %v", starts, sizes, wantStarts, wantSizes)
+	}
+
+	// Early stop
+	n := 0
+	for range BucketBy(ids, time.Minute) {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("BucketBy() yielded %d windows after break", n)
+	}
+
+	// The same sequence can be ranged again
+	seq := BucketBy(ids, time.Minute)
+	for pass := 0; pass < 2; pass++ {
+		n = 0
+		for range seq {
+			n++
+		}
+		if n != len(wantStarts) {
+			t.Errorf("BucketBy() pass %d yielded %d windows, want %d", pass, n, len(wantStarts))
+		}
+	}
+}
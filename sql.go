@@ -0,0 +1,92 @@
+package ulid
+
+import (
+	"database/sql"
+	"strconv"
+)
+
+// Placeholder is the bind parameter syntax of a SQL driver.
+type Placeholder int
+
+const (
+	// SQLQuestion uses "?" parameters, as MySQL and SQLite do
+	SQLQuestion Placeholder = iota
+
+	// SQLDollar uses numbered "$n" parameters, as PostgreSQL does
+	SQLDollar
+)
+
+// SQLArgMode selects the form in which SQLIn passes ULIDs to the driver.
+type SQLArgMode int
+
+const (
+	// SQLText passes ULIDs as 26 characters strings, matching Value
+	SQLText SQLArgMode = iota
+
+	// SQLBinary passes ULIDs as 16 bytes slices, for BINARY(16), BYTEA or
+	// UUID columns
+	SQLBinary
+)
+
+// SQLIn returns the comma separated placeholders and the matching driver
+// arguments for ids, ready to be used in an "IN (...)" clause:
+//
+//	ph, args := ulid.SQLIn(ids, ulid.SQLDollar, 1, ulid.SQLText)
+//	rows, err := db.Query("SELECT * FROM t WHERE id IN ("+ph+")", args...)
+//
+// SQLDollar placeholders are numbered from first, so that the clause can
+// follow other parameters. The encoded ULIDs share a single buffer instead
+// of being allocated one by one.
+func SQLIn(ids []ULID, p Placeholder, first int, mode SQLArgMode) (string, []interface{}) {
+	if len(ids) == 0 {
+		return "", nil
+	}
+
+	args := make([]interface{}, len(ids))
+	switch mode {
+	case SQLBinary:
+		buf := make([]byte, len(ids)*len(ULID{}))
+		for i, id := range ids {
+			b := buf[i*len(id) : (i+1)*len(id) : (i+1)*len(id)]
+			copy(b, id[:])
+			args[i] = b
+		}
+	default:
+		for i, s := range EncodeAll(ids) {
+			args[i] = s
+		}
+	}
+
+	var ph []byte
+	switch p {
+	case SQLDollar:
+		ph = make([]byte, 0, len(ids)*(len(strconv.Itoa(first+len(ids)))+2))
+		for i := range ids {
+			if i > 0 {
+				ph = append(ph, ',')
+			}
+			ph = append(ph, '$')
+			ph = strconv.AppendInt(ph, int64(first+i), 10)
+		}
+	default:
+		ph = make([]byte, 2*len(ids)-1)
+		for i := range ph {
+			ph[i] = "?,"[i%2]
+		}
+	}
+	return string(ph), args
+}
+
+// NullFrom returns a valid sql.Null[ULID] holding id. Even the zero ULID is
+// valid and stored as such; use sql.Null[ULID]{} to store NULL.
+func NullFrom(id ULID) sql.Null[ULID] {
+	return sql.Null[ULID]{V: id, Valid: true}
+}
+
+// FromNull returns the ULID held by n, or the zero ULID if n is NULL.
+func FromNull(n sql.Null[ULID]) ULID {
+	if !n.Valid {
+		return ULID{}
+	}
+	return n.V
+}
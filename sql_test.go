@@ -0,0 +1,80 @@
+package ulid
+
+import (
+	"bytes"
+	"database/sql"
+	"testing"
+)
+
+func TestSQLIn(t *testing.T) {
+	ids := []ULID{Make(), Make(), Make()}
+
+	tests := []struct {
+		p     Placeholder
+		first int
+		want  string
+	}{
+		{SQLQuestion, 1, "?,?,?"},
+		{SQLDollar, 1, "$1,$2,$3"},
+		{SQLDollar, 9, "$9,$10,$11"},
+	}
+	for _, tt := range tests {
+		ph, args := SQLIn(ids, tt.p, tt.first, SQLText)
+		if ph != tt.want {
+			t.Errorf("SQLIn(%v, %d) placeholders = %q, want %q", tt.p, tt.first, ph, tt.want)
+		}
+		if len(args) != len(ids) {
+			t.Fatalf("SQLIn() args = %v, want %d args", args, len(ids))
+		}
+		for i, id := range ids {
+			if args[i] != id.String() {
+				t.Errorf("SQLIn() args[%d] = %v, want %s", i, args[i], id)
+			}
+		}
+	}
+
+	_, args := SQLIn(ids, SQLQuestion, 1, SQLBinary)
+	for i, id := range ids {
+		if b, ok := args[i].([]byte); !ok || !bytes.Equal(b, id[:]) {
+			t.Errorf("SQLIn(SQLBinary) args[%d] = %v, want %v", i, args[i], id[:])
+		}
+	}
+
+	if ph, args := SQLIn(nil, SQLDollar, 1, SQLText); ph != "" || args != nil {
+		t.Errorf("SQLIn(nil) = %q, %v, want empty", ph, args)
+	}
+}
+
+func TestNull(t *testing.T) {
+	id := Make()
+
+	var n sql.Null[ULID]
+	if err := n.Scan(id.String()); err != nil || !n.Valid || n.V != id {
+		t.Errorf("Null.Scan(%s) = %v, %v, want valid %v", id, n, err, id)
+	}
+	if v, err := n.Value(); err != nil || v != id.String() {
+		t.Errorf("Null.Value() = %v, %v, want %v", v, err, id)
+	}
+	if err := n.Scan(nil); err != nil || n.Valid || !n.V.IsZero() {
+		t.Errorf("Null.Scan(nil) = %v, %v, want NULL", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != nil {
+		t.Errorf("Null.Value() = %v, %v, want nil", v, err)
+	}
+
+	if n := NullFrom(ULID{}); !n.Valid {
+		t.Error("NullFrom(zero) should be valid")
+	}
+	if got := FromNull(NullFrom(id)); got != id {
+		t.Errorf("FromNull(NullFrom(%v)) = %v", id, got)
+	}
+	if got := FromNull(sql.Null[ULID]{V: id}); !got.IsZero() {
+		t.Errorf("FromNull(NULL) = %v, want zero", got)
+	}
+
+	// A plain ULID scans NULL as zero, overwriting its previous value
+	got := id
+	if err := got.Scan(nil); err != nil || !got.IsZero() {
+		t.Errorf("Scan(nil) = %v, %v, want zero", got, err)
+	}
+}
@@ -0,0 +1,46 @@
+//go:build !(tinygo || wasm)
+
+package ulid
+
+import (
+	"sync"
+	"unique"
+	"weak"
+)
+
+// Cache pour les strings via weak pointers pour éviter les re-allocations
+// et permettre au GC de libérer la mémoire.
+type stringRef struct{ s string }
+
+var (
+	textBufferPool = sync.Pool{New: func() any { b := make([]byte, EncodedSize); return &b }}
+
+	// Cache global de strings pour les ULIDs via weak pointers.
+	stringCache sync.Map // map[Handle]weak.Pointer[stringRef]
+)
+
+// String utilise un cache de weak pointers pour éviter les allocations répétées
+// pour le même ULID, tout en restant sûr pour le GC.
+func (id ULID) String() string {
+	h := unique.Make(id)
+
+	// Tentative de lecture du cache
+	if v, ok := stringCache.Load(h); ok {
+		if ref := v.(weak.Pointer[stringRef]).Value(); ref != nil {
+			return ref.s
+		}
+	}
+
+	// Génération de la string
+	bufPtr := textBufferPool.Get().(*[]byte)
+	buf := *bufPtr
+	_ = id.MarshalTextTo(buf)
+	s := string(buf)
+	textBufferPool.Put(bufPtr)
+
+	// Stockage dans le cache avec weak pointer
+	ref := &stringRef{s: s}
+	stringCache.Store(h, weak.Make(ref))
+
+	return s
+}
@@ -0,0 +1,12 @@
+//go:build tinygo || wasm
+
+package ulid
+
+// String returns the canonical text encoding of the ULID.
+//
+// Sans cache : TinyGo n'a pas de weak pointers et la mémoire des cibles
+// wasm est trop limitée pour garder les strings.
+func (id ULID) String() string {
+	a := id.StringArray()
+	return string(a[:])
+}
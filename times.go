@@ -0,0 +1,121 @@
+package ulid
+
+import (
+	"time"
+)
+
+// CompareTime compares the timestamps of a and b, ignoring their entropy.
+// The result will be 0 if both were created in the same millisecond, -1 if
+// a is older than b, and +1 if a is newer than b.
+func CompareTime(a, b ULID) int {
+	ta, tb := a.Time(), b.Time()
+	switch {
+	case ta < tb:
+		return -1
+	case ta > tb:
+		return 1
+	}
+	return 0
+}
+
+// SameMillisecond returns true if id and other share the same timestamp.
+func (id ULID) SameMillisecond(other ULID) bool {
+	return id.Time() == other.Time()
+}
+
+// Timestamp returns the time encoded in id, in UTC.
+func (id ULID) Timestamp() time.Time {
+	return TimeUTC(id.Time())
+}
+
+// TimeIn returns the time encoded in id, in the location loc.
+//
+// TimeIn panics if loc is nil.
+func (id ULID) TimeIn(loc *time.Location) time.Time {
+	return Time(id.Time()).In(loc)
+}
+
+// Before returns true if the timestamp of id is before t.
+func (id ULID) Before(t time.Time) bool {
+	return Time(id.Time()).Before(t)
+}
+
+// After returns true if the timestamp of id is after t.
+func (id ULID) After(t time.Time) bool {
+	return Time(id.Time()).After(t)
+}
+
+// TimePrefix returns the first n characters of the encoded timestamp part
+// of id. n is clamped to the [0, 10] range. Shorter prefixes cover wider
+// time spans, which makes them usable as stable partition keys.
+func (id ULID) TimePrefix(n int) string {
+	n = max(0, min(n, 10))
+	var buf [EncodedSize]byte
+	_ = id.MarshalTextTo(buf[:])
+	return string(buf[:n])
+}
+
+// TruncateToWindow returns the ULID with a timestamp rounded down to a
+// multiple of d and zero entropy. IDs created within the same window share
+// the same result, e.g. hourly buckets with d = time.Hour.
+// If d <= 0, the ULID is returned with zero entropy and an unchanged timestamp.
+func (id ULID) TruncateToWindow(d time.Duration) ULID {
+	ms := id.Time()
+	if w := uint64(d.Milliseconds()); w > 0 {
+		ms -= ms % w
+	}
+	var res ULID
+	_ = res.SetTime(ms)
+	return res
+}
+
+// FirstOfMillisecond returns the smallest ULID of the millisecond of t, with
+// zero entropy. It is an inclusive lower bound for range scans starting at
+// t, or an exclusive upper bound for scans stopping before t.
+//
+// Times outside the range of ULIDs are clamped to it.
+func FirstOfMillisecond(t time.Time) ULID {
+	var id ULID
+	_ = id.SetTime(clampTimestamp(t))
+	return id
+}
+
+// LastOfMillisecond returns the greatest ULID of the millisecond of t, with
+// all the entropy bits set. It is an inclusive upper bound for range scans
+// ending at t.
+//
+// Times outside the range of ULIDs are clamped to it.
+func LastOfMillisecond(t time.Time) ULID {
+	id := FirstOfMillisecond(t)
+	for i := 6; i < RawSize; i++ {
+		id[i] = 0xFF
+	}
+	return id
+}
+
+// IsFuture returns true if the timestamp of id is more than tolerance ahead
+// of the current time, as with IDs from clock-skewed clients or forged ones.
+func (id ULID) IsFuture(tolerance time.Duration) bool {
+	return id.After(time.Now().Add(tolerance))
+}
+
+// IsOlderThan returns true if id was created more than d ago.
+func (id ULID) IsOlderThan(d time.Duration) bool {
+	return id.Before(time.Now().Add(-d))
+}
+
+// ExpiresAt returns the time at which an entry keyed by id expires, ttl
+// after the creation of id.
+func (id ULID) ExpiresAt(ttl time.Duration) time.Time {
+	return Time(id.Time()).Add(ttl)
+}
+
+// Expired returns true if an entry keyed by id has outlived ttl. The current
+// time is used unless now is given.
+func (id ULID) Expired(ttl time.Duration, now ...time.Time) bool {
+	t := time.Now()
+	if len(now) > 0 {
+		t = now[0]
+	}
+	return !t.Before(id.ExpiresAt(ttl))
+}
@@ -0,0 +1,163 @@
+package ulid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCompareTime(t *testing.T) {
+	a := MustNew(1000, nil)
+	b := MustNew(1000, nil)
+	c := MustNew(2000, nil)
+
+	if CompareTime(a, b) != 0 || !a.SameMillisecond(b) {
+		t.Error("ULIDs with the same timestamp should compare equal")
+	}
+	if CompareTime(a, c) != -1 || CompareTime(c, a) != 1 {
+		t.Error("CompareTime() returned wrong order")
+	}
+	if a.SameMillisecond(c) {
+		t.Error("SameMillisecond() = true for different timestamps")
+	}
+}
+
+func TestBeforeAfter(t *testing.T) {
+	now := time.Now()
+	id := MakeWithTime(now)
+
+	if !id.Before(now.Add(time.Second)) || id.Before(now.Add(-time.Second)) {
+		t.Error("Before() returned wrong result")
+	}
+	if !id.After(now.Add(-time.Second)) || id.After(now.Add(time.Second)) {
+		t.Error("After() returned wrong result")
+	}
+}
+
+func TestTimePrefix(t *testing.T) {
+	id := Make()
+	s := id.String()
+
+	if got := id.TimePrefix(6); got != s[:6] {
+		t.Errorf("TimePrefix(6) = %v, want %v", got, s[:6])
+	}
+	if got := id.TimePrefix(20); got != s[:10] {
+		t.Errorf("TimePrefix(20) = %v, want %v", got, s[:10])
+	}
+	if got := id.TimePrefix(-1); got != "" {
+		t.Errorf("TimePrefix(-1) = %q, want empty", got)
+	}
+}
+
+func TestTruncateToWindow(t *testing.T) {
+	base := time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC)
+	a := MakeWithTime(base.Add(5 * time.Minute))
+	b := MakeWithTime(base.Add(55 * time.Minute))
+
+	wa, wb := a.TruncateToWindow(time.Hour), b.TruncateToWindow(time.Hour)
+	if wa != wb {
+		t.Errorf("TruncateToWindow() = %v and %v, want equal", wa, wb)
+	}
+	if wa.Time() != Timestamp(base) {
+		t.Errorf("TruncateToWindow().Time() = %v, want %v", wa.Time(), Timestamp(base))
+	}
+	for _, b := range wa.Entropy() {
+		if b != 0 {
+			t.Fatal("TruncateToWindow() entropy should be zero")
+		}
+	}
+}
+
+func TestFirstLastOfMillisecond(t *testing.T) {
+	now := time.Now()
+	first, last := FirstOfMillisecond(now), LastOfMillisecond(now)
+
+	if first.Time() != Timestamp(now) || last.Time() != Timestamp(now) {
+		t.Errorf("FirstOfMillisecond/LastOfMillisecond time = %d, %d, want %d", first.Time(), last.Time(), Timestamp(now))
+	}
+	if first.TrailingZeros() < 80 {
+		t.Errorf("FirstOfMillisecond() entropy = %x, want zero", first.Entropy())
+	}
+
+	id := MakeWithTime(now)
+	if id.Compare(first) < 0 || id.Compare(last) > 0 {
+		t.Errorf("%v not in [%v, %v]", id, first, last)
+	}
+	if next, _ := last.Increment(); next != FirstOfMillisecond(now.Add(time.Millisecond)) {
+		t.Errorf("LastOfMillisecond()+1 = %v, want first of next millisecond", next)
+	}
+
+	if got := FirstOfMillisecond(time.UnixMilli(-5)); !got.IsZero() {
+		t.Errorf("FirstOfMillisecond(before epoch) = %v, want zero", got)
+	}
+}
+
+func TestIsFutureIsOlderThan(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		id     ULID
+		future bool
+		older  bool
+	}{
+		{MakeWithTime(now), false, false},
+		{MakeWithTime(now.Add(time.Second)), false, false},
+		{MakeWithTime(now.Add(time.Hour)), true, false},
+		{MakeWithTime(now.Add(-time.Hour)), false, true},
+	}
+	for _, tt := range tests {
+		if got := tt.id.IsFuture(time.Minute); got != tt.future {
+			t.Errorf("IsFuture(1m) for %v = %v, want %v", Time(tt.id.Time()), got, tt.future)
+		}
+		if got := tt.id.IsOlderThan(time.Minute); got != tt.older {
+			t.Errorf("IsOlderThan(1m) for %v = %v, want %v", Time(tt.id.Time()), got, tt.older)
+		}
+	}
+}
+
+func TestExpired(t *testing.T) {
+	created := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	id := MakeWithTime(created)
+
+	if got, want := id.ExpiresAt(time.Hour), created.Add(time.Hour); !got.Equal(want) {
+		t.Errorf("ExpiresAt() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		now  time.Time
+		want bool
+	}{
+		{created, false},
+		{created.Add(59 * time.Minute), false},
+		{created.Add(time.Hour), true},
+		{created.Add(2 * time.Hour), true},
+	}
+	for _, tt := range tests {
+		if got := id.Expired(time.Hour, tt.now); got != tt.want {
+			t.Errorf("Expired(1h, %v) = %v, want %v", tt.now, got, tt.want)
+		}
+	}
+
+	if !id.Expired(time.Hour) {
+		t.Error("Expired(1h) = false for an ID from 2024")
+	}
+	if Make().Expired(time.Hour) {
+		t.Error("Expired(1h) = true for a new ID")
+	}
+}
+
+func TestTimeLocation(t *testing.T) {
+	const ms = 1469918176385
+	id := MustNew(ms, nil)
+	want := time.UnixMilli(ms)
+
+	if got := TimeUTC(ms); got.Location() != time.UTC || !got.Equal(want) {
+		t.Errorf("TimeUTC() = %v, want %v in UTC", got, want)
+	}
+	if got := id.Timestamp(); got.Location() != time.UTC || got.Format(time.RFC3339Nano) != "2016-07-30T22:36:16.385Z" {
+		t.Errorf("Timestamp() = %v, want 2016-07-30T22:36:16.385Z", got)
+	}
+
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	if got := id.TimeIn(loc); got.Location() != loc || got.Format(time.RFC3339) != "2016-07-31T00:36:16+02:00" {
+		t.Errorf("TimeIn() = %v, want 2016-07-31T00:36:16+02:00", got)
+	}
+}
@@ -0,0 +1,79 @@
+package ulid
+
+import (
+	"encoding/hex"
+	"errors"
+)
+
+// ErrTraceparent is returned when parsing a malformed W3C traceparent header
+var ErrTraceparent = errors.New("ulid: invalid traceparent header")
+
+// TraceID returns the ULID as a 16 byte trace ID, assignable to an
+// OpenTelemetry trace.TraceID. Both share the same size and byte order, so
+// trace IDs created this way stay sortable by time.
+func (id ULID) TraceID() [16]byte {
+	return id
+}
+
+// FromTraceID returns the ULID stored in an OpenTelemetry trace ID.
+func FromTraceID(tid [16]byte) ULID {
+	return tid
+}
+
+// SpanID derives an 8 byte span ID, assignable to an OpenTelemetry
+// trace.SpanID, from the last 8 entropy bytes of the ULID.
+func (id ULID) SpanID() [8]byte {
+	return [8]byte(id[8:])
+}
+
+// Traceparent returns a W3C traceparent header value using the ULID as the
+// trace ID and its derived SpanID as the parent ID, with the sampled flag set.
+func (id ULID) Traceparent() string {
+	// 00-<32 hex>-<16 hex>-01
+	buf := make([]byte, 55)
+	copy(buf, "00-")
+	hex.Encode(buf[3:35], id[:])
+	buf[35] = '-'
+	hex.Encode(buf[36:52], id[8:])
+	copy(buf[52:], "-01")
+	return string(buf)
+}
+
+// ParseTraceparent returns the ULID stored as trace ID in a W3C traceparent
+// header value. ErrTraceparent is returned if the header is malformed, uses
+// the invalid version ff, or carries an all-zero trace ID or parent ID.
+// Headers of a later version may be longer than 55 characters, as long as
+// the extra fields follow a dash.
+func ParseTraceparent(s string) (ULID, error) {
+	if len(s) < 55 || s[2] != '-' || s[35] != '-' || s[52] != '-' {
+		return ULID{}, ErrTraceparent
+	}
+	if !isLowerHex(s[:2]) || s[:2] == "ff" || !isLowerHex(s[3:35]) || !isLowerHex(s[36:52]) || !isLowerHex(s[53:55]) {
+		return ULID{}, ErrTraceparent
+	}
+	if len(s) > 55 && (s[:2] == "00" || s[55] != '-') {
+		return ULID{}, ErrTraceparent
+	}
+	if s[36:52] == "0000000000000000" {
+		return ULID{}, ErrTraceparent
+	}
+
+	var id ULID
+	hex.Decode(id[:], []byte(s[3:35]))
+	if id == (ULID{}) {
+		return ULID{}, ErrTraceparent
+	}
+	return id, nil
+}
+
+// isLowerHex reports whether s only holds lower case hex digits, as the
+// traceparent header requires.
+func isLowerHex(s string) bool {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,67 @@
+package ulid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTraceID(t *testing.T) {
+	id := Make()
+
+	tid := id.TraceID()
+	if FromTraceID(tid) != id {
+		t.Error("FromTraceID(TraceID()) should return the same ULID")
+	}
+
+	sid := id.SpanID()
+	if !bytes.Equal(sid[:], id[8:]) {
+		t.Errorf("SpanID() = %x, want %x", sid, id[8:])
+	}
+}
+
+func TestTraceparent(t *testing.T) {
+	id := Make()
+	tp := id.Traceparent()
+	if len(tp) != 55 || tp[:3] != "00-" || tp[52:] != "-01" {
+		t.Errorf("Traceparent() = %v", tp)
+	}
+
+	parsed, err := ParseTraceparent(tp)
+	if err != nil {
+		t.Fatalf("ParseTraceparent() error = %v", err)
+	}
+	if parsed != id {
+		t.Errorf("ParseTraceparent(Traceparent()) = %v, want %v", parsed, id)
+	}
+
+	// Versions after 00 may append fields
+	if got, err := ParseTraceparent("01" + tp[2:] + "-extra"); err != nil || got != id {
+		t.Errorf("ParseTraceparent(version 01) = %v, %v, want %v", got, err, id)
+	}
+
+	const (
+		tid = "4bf92f3577b34da6a3ce929d0e0e4736"
+		pid = "00f067aa0ba902b7"
+	)
+	if _, err := ParseTraceparent("00-" + tid + "-" + pid + "-01"); err != nil {
+		t.Errorf("ParseTraceparent(valid) error = %v", err)
+	}
+	for _, s := range []string{
+		"00-zz",
+		"0g-" + tid + "-" + pid + "-01",
+		"ff-" + tid + "-" + pid + "-01",
+		"00-" + tid + "-" + pid + "-01-extra",
+		"00-" + tid + "-" + pid + "-01x",
+		"01-" + tid + "-" + pid + "-01x",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-" + pid + "-01",
+		"00-" + tid[:31] + "z-" + pid + "-01",
+		"00-" + tid + "-00f067aa0ba902bz-01",
+		"00-" + tid + "-" + pid + "-0z",
+		"00-00000000000000000000000000000000-" + pid + "-01",
+		"00-" + tid + "-0000000000000000-01",
+	} {
+		if _, err := ParseTraceparent(s); err != ErrTraceparent {
+			t.Errorf("ParseTraceparent(%q) error = %v, want %v", s, err, ErrTraceparent)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package ulid
+
+import (
+	"database/sql/driver"
+	"errors"
+)
+
+// ErrPrefix is returned when parsing a TypedID whose prefix does not match
+// its type
+var ErrPrefix = errors.New("ulid: invalid prefix")
+
+// Prefixer is implemented by the marker types of TypedID. Prefix must
+// return a constant.
+type Prefixer interface {
+	Prefix() string
+}
+
+// TypedID is a ULID bound to an entity type T, rendered with the prefix of
+// T, as in usr_01ARZ3NDEKTSV4RRFFQ69G5FAV. IDs of different entities are
+// different types, so passing an order ID where a user ID is expected does
+// not compile.
+//
+//	type User struct{}
+//
+//	func (User) Prefix() string { return "usr" }
+//
+//	type UserID = ulid.TypedID[User]
+type TypedID[T Prefixer] struct {
+	id ULID
+}
+
+// NewTyped returns id as a TypedID of T.
+func NewTyped[T Prefixer](id ULID) TypedID[T] {
+	return TypedID[T]{id: id}
+}
+
+// MakeTyped returns a new TypedID of T with the current time.
+func MakeTyped[T Prefixer]() TypedID[T] {
+	return TypedID[T]{id: Make()}
+}
+
+// ParseTyped parses a prefixed ID of T. ErrPrefix is returned if the
+// prefix does not match T, otherwise the errors are the ones of ParseStrict.
+func ParseTyped[T Prefixer](s string) (TypedID[T], error) {
+	var t TypedID[T]
+	return t, t.UnmarshalText([]byte(s))
+}
+
+func (t TypedID[T]) prefix() string {
+	var p T
+	return p.Prefix()
+}
+
+// ULID returns the underlying ULID.
+func (t TypedID[T]) ULID() ULID {
+	return t.id
+}
+
+// IsZero returns true if the underlying ULID is zero.
+func (t TypedID[T]) IsZero() bool {
+	return t.id.IsZero()
+}
+
+// Compare compares the underlying ULIDs, see ULID.Compare.
+func (t TypedID[T]) Compare(other TypedID[T]) int {
+	return t.id.Compare(other.id)
+}
+
+// String returns the prefixed text form of the ID.
+func (t TypedID[T]) String() string {
+	b, _ := t.MarshalText()
+	return string(b)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (t TypedID[T]) MarshalText() ([]byte, error) {
+	p := t.prefix()
+	buf := make([]byte, len(p)+1+EncodedSize)
+	copy(buf, p)
+	buf[len(p)] = '_'
+	return buf, t.id.MarshalTextTo(buf[len(p)+1:])
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (t *TypedID[T]) UnmarshalText(v []byte) error {
+	p := t.prefix()
+	if len(v) != len(p)+1+EncodedSize || string(v[:len(p)]) != p || v[len(p)] != '_' {
+		return ErrPrefix
+	}
+	return decode(&t.id, v[len(p)+1:], true)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (t TypedID[T]) MarshalJSON() ([]byte, error) {
+	b, _ := t.MarshalText()
+	res := make([]byte, 0, len(b)+2)
+	res = append(res, '"')
+	res = append(res, b...)
+	return append(res, '"'), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. A JSON null is
+// a no-op.
+func (t *TypedID[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return ErrDataSize
+	}
+	return t.UnmarshalText(data[1 : len(data)-1])
+}
+
+// Scan implements the sql.Scanner interface. It supports scanning the
+// prefixed text form from a string or byte slice. A NULL value sets the
+// zero TypedID, like ULID.Scan.
+func (t *TypedID[T]) Scan(src interface{}) error {
+	switch x := src.(type) {
+	case nil:
+		*t = TypedID[T]{}
+		return nil
+	case string:
+		return t.UnmarshalText([]byte(x))
+	case []byte:
+		return t.UnmarshalText(x)
+	}
+	return ErrScanValue
+}
+
+// Value implements the sql/driver.Valuer interface, returning the prefixed
+// text form.
+func (t TypedID[T]) Value() (driver.Value, error) {
+	return t.String(), nil
+}
@@ -0,0 +1,72 @@
+package ulid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type testUser struct{}
+
+func (testUser) Prefix() string { return "usr" }
+
+type testOrder struct{}
+
+func (testOrder) Prefix() string { return "ord" }
+
+func TestTypedID(t *testing.T) {
+	id := Make()
+	uid := NewTyped[testUser](id)
+
+	s := uid.String()
+	if s != "usr_"+id.String() {
+		t.Errorf("String() = %v, want usr_%v", s, id)
+	}
+
+	parsed, err := ParseTyped[testUser](s)
+	if err != nil {
+		t.Fatalf("ParseTyped() error = %v", err)
+	}
+	if parsed != uid || parsed.ULID() != id {
+		t.Errorf("ParseTyped(String()) = %v, want %v", parsed, uid)
+	}
+
+	if _, err := ParseTyped[testOrder](s); err != ErrPrefix {
+		t.Errorf("ParseTyped() with a wrong prefix error = %v, want %v", err, ErrPrefix)
+	}
+	if _, err := ParseTyped[testUser](id.String()); err != ErrPrefix {
+		t.Errorf("ParseTyped() without prefix error = %v, want %v", err, ErrPrefix)
+	}
+	if _, err := ParseTyped[testUser]("usr_01ARZ3NDEKTSV4RRFFQ69G5F!V"); err != ErrInvalidCharacters {
+		t.Errorf("ParseTyped() invalid error = %v, want %v", err, ErrInvalidCharacters)
+	}
+}
+
+func TestTypedIDEncoding(t *testing.T) {
+	type payload struct {
+		User  TypedID[testUser]  `json:"user"`
+		Order TypedID[testOrder] `json:"order"`
+	}
+
+	p := payload{User: MakeTyped[testUser](), Order: MakeTyped[testOrder]()}
+	data, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	var p2 payload
+	if err := json.Unmarshal(data, &p2); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if p2 != p {
+		t.Errorf("json.Unmarshal(json.Marshal()) = %v, want %v", p2, p)
+	}
+
+	v, _ := p.User.Value()
+	var u TypedID[testUser]
+	if err := u.Scan(v); err != nil || u != p.User {
+		t.Errorf("Scan(Value()) = %v, %v, want %v", u, err, p.User)
+	}
+	if err := u.Scan(nil); err != nil || u != (TypedID[testUser]{}) {
+		t.Errorf("Scan(nil) = %v, %v, want zero", u, err)
+	}
+}
@@ -2,16 +2,15 @@ package ulid
 
 import (
 	"crypto/rand"
+	"crypto/subtle"
 	"database/sql/driver"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"math"
 	"math/bits"
-	"sync"
 	"time"
-	"unique"
-	"weak"
 )
 
 const (
@@ -21,6 +20,9 @@ const (
 	// RawSize is the length of a binary encoded ULID
 	RawSize = 16
 
+	// EntropySize is the length of the entropy of a ULID, its last 10 bytes
+	EntropySize = RawSize - 6
+
 	// MaxTime is the maximum Unix time in milliseconds that can be
 	// represented in a ULID
 	MaxTime = math.MaxUint64 >> 16
@@ -43,6 +45,10 @@ var (
 	// than MaxTime
 	ErrBigTime = errors.New("ulid: time too big")
 
+	// ErrNegativeTime is returned when constructing a ULID with a time before
+	// the Unix epoch
+	ErrNegativeTime = errors.New("ulid: time before the Unix epoch")
+
 	// ErrOverflow is returned when unmarshaling a ULID whose first character is
 	// larger than 7, thereby exceeding the valid bit depth of 128
 	ErrOverflow = errors.New("ulid: overflow when unmarshaling")
@@ -57,45 +63,27 @@ var (
 )
 
 // ULID is a 16 byte Universally Unique Lexicographically Sortable Identifier
+//
+// Being an array, a ULID is comparable: it can be used as a map key, with
+// == and as the type argument of a comparable type parameter. Its byte
+// order is its sort order, so Compare and Less fit the slices functions.
 type ULID [RawSize]byte
 
-// Handle est un wrapper pour unique.Handle[ULID], permettant des comparaisons de pointeurs.
-type Handle = unique.Handle[ULID]
-
-// Handle retourne un handle unique pour cet ULID (interning).
-func (id ULID) Handle() Handle {
-	return unique.Make(id)
-}
-
-// Cache pour les strings via weak pointers pour éviter les re-allocations
-// et permettre au GC de libérer la mémoire.
-type stringRef struct{ s string }
-
-var (
-	textBufferPool = sync.Pool{New: func() any { b := make([]byte, EncodedSize); return &b }}
-
-	// Cache global de strings pour les ULIDs via weak pointers.
-	stringCache sync.Map // map[Handle]weak.Pointer[stringRef]
-)
-
 // New returns a ULID with the given Unix milliseconds timestamp and an optional
 // entropy source. Use the Timestamp function to convert a time.Time to Unix
-// milliseconds.
+// milliseconds. A nil entropy, like crypto/rand.Reader, reads crypto/rand
+// or the source set with SetEntropySource.
 //
-// ErrBigTime is returned when passing a timestamp bigger than MaxTime.
-// Reading from the entropy source may also return an error.
-//
-// Safety for concurrent use is only dependent on the safety of the entropy source.
-// New returns a ULID with the given Unix milliseconds timestamp and an optional
-// entropy source. Use the Timestamp function to convert a time.Time to Unix
-// milliseconds.
-//
-// ErrBigTime is returned when passing a timestamp bigger than MaxTime.
+// ErrBigTime is returned when passing a timestamp bigger than MaxTime, and
+// ErrNegativeTime when passing a negative Unix time converted to uint64.
 // Reading from the entropy source may also return an error.
 //
 // Safety for concurrent use is only dependent on the safety of the entropy source.
 func New(ms uint64, entropy io.Reader) (ULID, error) {
 	if ms > MaxTime {
+		if int64(ms) < 0 {
+			return ULID{}, ErrNegativeTime
+		}
 		return ULID{}, ErrBigTime
 	}
 
@@ -109,7 +97,7 @@ func New(ms uint64, entropy io.Reader) (ULID, error) {
 
 	// Chemin fast-path : 0 allocation garanti car rand.Read est une fonction directe
 	if entropy == nil || entropy == rand.Reader {
-		if _, err := rand.Read(id[6:]); err != nil {
+		if _, err := readRandom(id[6:]); err != nil {
 			return ULID{}, err
 		}
 		return id, nil
@@ -136,8 +124,31 @@ func MustNew(ms uint64, entropy io.Reader) ULID {
 	return id
 }
 
+// FromParts returns the ULID made of the timestamp ms and the given
+// entropy, e.g. to rebuild IDs stored as separate columns.
+//
+// ErrBigTime is returned when passing a timestamp bigger than MaxTime.
+func FromParts(ms uint64, entropy [EntropySize]byte) (ULID, error) {
+	var id ULID
+	if err := id.SetTime(ms); err != nil {
+		return id, err
+	}
+	id.SetEntropyArray(entropy)
+	return id, nil
+}
+
 // Make est ultra-optimisé et inlinable
+//
+// When a default generator is set with SetDefaultGenerator, Make uses it and
+// panics if it fails. Otherwise a failure to read crypto/rand, possible on
+// some embedded or TinyGo targets, is reported to the hook set with
+// SetEntropyFailureHook and leaves part of the entropy zero; use TryMake
+// to handle it instead.
 func Make() ULID {
+	if g := defaultGenerator.Load(); g != nil {
+		return g.Make()
+	}
+
 	var id ULID
 	ms := uint64(time.Now().UnixMilli())
 
@@ -149,23 +160,58 @@ func Make() ULID {
 	id[4] = byte(ms >> 8)
 	id[5] = byte(ms)
 
-	_, _ = rand.Read(id[6:])
+	if _, err := readRandom(id[6:]); err != nil {
+		entropyFailed(err)
+	}
 	return id
 }
 
+// TryMake is like Make, but returns the error of crypto/rand or of the
+// default generator instead of ignoring it or panicking.
+func TryMake() (ULID, error) {
+	if g := defaultGenerator.Load(); g != nil {
+		return g.New()
+	}
+	return New(uint64(time.Now().UnixMilli()), nil)
+}
+
 // MakeWithTime returns a ULID with the given time and entropy from the
-// default entropy source (crypto/rand.Reader).
+// default entropy source (crypto/rand.Reader), or from the default
+// generator set with SetDefaultGenerator. It panics on failure, including
+// with ErrNegativeTime for times before the Unix epoch such as the zero
+// time.Time; use MustNew(Timestamp(t), nil) to clamp them instead.
 func MakeWithTime(t time.Time) ULID {
-	return MustNew(Timestamp(t), nil)
+	if g := defaultGenerator.Load(); g != nil {
+		id, err := g.NewWithTime(t)
+		if err != nil {
+			panic(err)
+		}
+		return id
+	}
+	ms, err := timestampOf(t)
+	if err != nil {
+		panic(err)
+	}
+	return MustNew(ms, nil)
 }
 
 // Parse parses an encoded ULID, returning an error in case of failure.
 //
 // ErrDataSize is returned if the len(ulid) is different from EncodedSize.
 // Invalid encodings produce undefined ULIDs. For a version that returns
-// an error instead, see ParseStrict.
+// an error instead, see ParseStrict. Parse never allocates, even on error.
 func Parse(s string) (id ULID, err error) {
-	return parse([]byte(s), false)
+	return id, decode(&id, s, false)
+}
+
+// MustParse is like ParseStrict but panics on error. It simplifies the
+// initialization of global variables and test fixtures.
+func MustParse(s string) ULID {
+	id, err := ParseStrict(s)
+	if err != nil {
+		panic(err)
+	}
+	return id
 }
 
 // ParseStrict parses an encoded ULID, returning an error in case of failure.
@@ -177,86 +223,68 @@ func Parse(s string) (id ULID, err error) {
 // ErrInvalidCharacters is returned if the parsed ULID contains invalid
 // base32 characters.
 func ParseStrict(s string) (id ULID, err error) {
-	return parse([]byte(s), true)
+	return id, decode(&id, s, true)
 }
 
-func parse(v []byte, strict bool) (id ULID, err error) {
+// decode parses v into id, which is left untouched on error. Characters are
+// shifted straight into two 64-bit halves instead of byte by byte. Invalid
+// characters map to 0xFF, so OR-ing the looked up values and testing the
+// high bits validates a whole run of characters at once.
+func decode[T string | []byte](id *ULID, v T, strict bool) error {
 	if len(v) != EncodedSize {
-		return id, ErrDataSize
+		return ErrDataSize
 	}
+	_ = v[EncodedSize-1]
 
 	// 6 bytes timestamp (48 bits)
-	if dec[v[0]] == 0xFF ||
-		dec[v[1]] == 0xFF ||
-		dec[v[2]] == 0xFF ||
-		dec[v[3]] == 0xFF ||
-		dec[v[4]] == 0xFF ||
-		dec[v[5]] == 0xFF ||
-		dec[v[6]] == 0xFF ||
-		dec[v[7]] == 0xFF ||
-		dec[v[8]] == 0xFF ||
-		dec[v[9]] == 0xFF {
-		return id, ErrInvalidCharacters
+	if (dec[v[0]]|dec[v[1]]|dec[v[2]]|dec[v[3]]|dec[v[4]]|dec[v[5]]|
+		dec[v[6]]|dec[v[7]]|dec[v[8]]|dec[v[9]])&0xE0 != 0 {
+		return ErrInvalidCharacters
 	}
 
 	// Validate base32 encoding
 	if v[0] > '7' {
-		return id, ErrOverflow
-	}
-
-	// Decode
-	id[0] = (dec[v[0]] << 5) | dec[v[1]]
-	id[1] = (dec[v[2]] << 3) | (dec[v[3]] >> 2)
-	id[2] = (dec[v[3]] << 6) | (dec[v[4]] << 1) | (dec[v[5]] >> 4)
-	id[3] = (dec[v[5]] << 4) | (dec[v[6]] >> 1)
-	id[4] = (dec[v[6]] << 7) | (dec[v[7]] << 2) | (dec[v[8]] >> 3)
-	id[5] = (dec[v[8]] << 5) | dec[v[9]]
-	id[6] = (dec[v[10]] << 3) | (dec[v[11]] >> 2)
-	id[7] = (dec[v[11]] << 6) | (dec[v[12]] << 1) | (dec[v[13]] >> 4)
-	id[8] = (dec[v[13]] << 4) | (dec[v[14]] >> 1)
-	id[9] = (dec[v[14]] << 7) | (dec[v[15]] << 2) | (dec[v[16]] >> 3)
-	id[10] = (dec[v[16]] << 5) | dec[v[17]]
-	id[11] = (dec[v[18]] << 3) | (dec[v[19]] >> 2)
-	id[12] = (dec[v[19]] << 6) | (dec[v[20]] << 1) | (dec[v[21]] >> 4)
-	id[13] = (dec[v[21]] << 4) | (dec[v[22]] >> 1)
-	id[14] = (dec[v[22]] << 7) | (dec[v[23]] << 2) | (dec[v[24]] >> 3)
-	id[15] = (dec[v[24]] << 5) | dec[v[25]]
-
-	if strict {
-		for _, c := range v {
-			if dec[c] == 0xFF {
-				return id, ErrInvalidCharacters
-			}
-		}
+		return ErrOverflow
 	}
 
-	return id, nil
-}
-
-// String utilise un cache de weak pointers pour éviter les allocations répétées
-// pour le même ULID, tout en restant sûr pour le GC.
-func (id ULID) String() string {
-	h := unique.Make(id)
-
-	// Tentative de lecture du cache
-	if v, ok := stringCache.Load(h); ok {
-		if ref := v.(weak.Pointer[stringRef]).Value(); ref != nil {
-			return ref.s
-		}
+	if strict && (dec[v[10]]|dec[v[11]]|dec[v[12]]|dec[v[13]]|dec[v[14]]|
+		dec[v[15]]|dec[v[16]]|dec[v[17]]|dec[v[18]]|dec[v[19]]|
+		dec[v[20]]|dec[v[21]]|dec[v[22]]|dec[v[23]]|dec[v[24]]|
+		dec[v[25]])&0xE0 != 0 {
+		return ErrInvalidCharacters
 	}
 
-	// Génération de la string
-	bufPtr := textBufferPool.Get().(*[]byte)
-	buf := *bufPtr
-	_ = id.MarshalTextTo(buf)
-	s := string(buf)
-	textBufferPool.Put(bufPtr)
+	// Decode. The entropy characters are masked: in lenient mode an invalid
+	// one is 0xFF and its spare bits would spill into the neighbouring ones,
+	// up to the timestamp.
+	hi := uint64(dec[v[0]])<<61 | uint64(dec[v[1]])<<56 | uint64(dec[v[2]])<<51 |
+		uint64(dec[v[3]])<<46 | uint64(dec[v[4]])<<41 | uint64(dec[v[5]])<<36 |
+		uint64(dec[v[6]])<<31 | uint64(dec[v[7]])<<26 | uint64(dec[v[8]])<<21 |
+		uint64(dec[v[9]])<<16 | uint64(dec[v[10]]&31)<<11 | uint64(dec[v[11]]&31)<<6 |
+		uint64(dec[v[12]]&31)<<1 | uint64(dec[v[13]]&31)>>4
+	lo := uint64(dec[v[13]]&31)<<60 | uint64(dec[v[14]]&31)<<55 |
+		uint64(dec[v[15]]&31)<<50 | uint64(dec[v[16]]&31)<<45 |
+		uint64(dec[v[17]]&31)<<40 | uint64(dec[v[18]]&31)<<35 |
+		uint64(dec[v[19]]&31)<<30 | uint64(dec[v[20]]&31)<<25 |
+		uint64(dec[v[21]]&31)<<20 | uint64(dec[v[22]]&31)<<15 |
+		uint64(dec[v[23]]&31)<<10 | uint64(dec[v[24]]&31)<<5 | uint64(dec[v[25]]&31)
+	binary.BigEndian.PutUint64(id[:8], hi)
+	binary.BigEndian.PutUint64(id[8:], lo)
+	return nil
+}
 
-	// Stockage dans le cache avec weak pointer
-	ref := &stringRef{s: s}
-	stringCache.Store(h, weak.Make(ref))
+// StringArray returns the text encoding of the ULID by value, so it can be
+// kept in structs or on the stack without any heap allocation.
+func (id ULID) StringArray() [EncodedSize]byte {
+	var a [EncodedSize]byte
+	_ = id.MarshalTextTo(a[:])
+	return a
+}
 
-	return s
+// GoString implements the fmt.GoStringer interface, so %#v prints a ULID as
+// Go code that can be pasted back into tests.
+func (id ULID) GoString() string {
+	return `ulid.MustParse("` + id.String() + `")`
 }
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface by
@@ -277,6 +305,17 @@ func (id ULID) MarshalBinaryTo(dst []byte) error {
 	return nil
 }
 
+// MarshalBinaryInto writes the binary encoding of the ULID at the start of
+// dst and returns the number of bytes written, RawSize. Unlike
+// MarshalBinaryTo, dst may be larger than needed. ErrBufferSize is
+// returned when len(dst) < RawSize.
+func (id ULID) MarshalBinaryInto(dst []byte) (int, error) {
+	if len(dst) < RawSize {
+		return 0, ErrBufferSize
+	}
+	return copy(dst, id[:]), nil
+}
+
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface by
 // copying the passed data and converting it to a ULID. ErrDataSize is
 // returned if the data length is different from RawSize.
@@ -296,43 +335,25 @@ func (id ULID) MarshalText() ([]byte, error) {
 	return ulid, id.MarshalTextTo(ulid)
 }
 
+// MarshalTextInto writes the ULID as a string at the start of dst and
+// returns the number of bytes written, EncodedSize. Unlike MarshalTextTo,
+// dst may be larger than needed. ErrBufferSize is returned when
+// len(dst) < EncodedSize.
+func (id ULID) MarshalTextInto(dst []byte) (int, error) {
+	if len(dst) < EncodedSize {
+		return 0, ErrBufferSize
+	}
+	_ = id.MarshalTextTo(dst[:EncodedSize])
+	return EncodedSize, nil
+}
+
 // MarshalTextTo writes the ULID as a string to the given buffer.
 // ErrBufferSize is returned when the len(dst) != EncodedSize.
 func (id ULID) MarshalTextTo(dst []byte) error {
 	if len(dst) != EncodedSize {
 		return ErrBufferSize
 	}
-
-	// 10 byte timestamp
-	dst[0] = enc[(id[0]&224)>>5]
-	dst[1] = enc[id[0]&31]
-	dst[2] = enc[(id[1]&248)>>3]
-	dst[3] = enc[((id[1]&7)<<2)|((id[2]&192)>>6)]
-	dst[4] = enc[(id[2]&62)>>1]
-	dst[5] = enc[((id[2]&1)<<4)|((id[3]&240)>>4)]
-	dst[6] = enc[((id[3]&15)<<1)|((id[4]&128)>>7)]
-	dst[7] = enc[(id[4]&124)>>2]
-	dst[8] = enc[((id[4]&3)<<3)|((id[5]&224)>>5)]
-	dst[9] = enc[id[5]&31]
-
-	// 16 bytes of entropy
-	dst[10] = enc[(id[6]&248)>>3]
-	dst[11] = enc[((id[6]&7)<<2)|((id[7]&192)>>6)]
-	dst[12] = enc[(id[7]&62)>>1]
-	dst[13] = enc[((id[7]&1)<<4)|((id[8]&240)>>4)]
-	dst[14] = enc[((id[8]&15)<<1)|((id[9]&128)>>7)]
-	dst[15] = enc[(id[9]&124)>>2]
-	dst[16] = enc[((id[9]&3)<<3)|((id[10]&224)>>5)]
-	dst[17] = enc[id[10]&31]
-	dst[18] = enc[(id[11]&248)>>3]
-	dst[19] = enc[((id[11]&7)<<2)|((id[12]&192)>>6)]
-	dst[20] = enc[(id[12]&62)>>1]
-	dst[21] = enc[((id[12]&1)<<4)|((id[13]&240)>>4)]
-	dst[22] = enc[((id[13]&15)<<1)|((id[14]&128)>>7)]
-	dst[23] = enc[(id[14]&124)>>2]
-	dst[24] = enc[((id[14]&3)<<3)|((id[15]&224)>>5)]
-	dst[25] = enc[id[15]&31]
-
+	encodeText(dst, &id)
 	return nil
 }
 
@@ -342,12 +363,7 @@ func (id ULID) MarshalTextTo(dst []byte) error {
 // ErrDataSize is returned if the len(v) is different from an encoded
 // ULID's length. Invalid encodings produce undefined ULIDs.
 func (id *ULID) UnmarshalText(v []byte) error {
-	ulid, err := parse(v, false)
-	if err != nil {
-		return err
-	}
-	*id = ulid
-	return nil
+	return decode(id, v, false)
 }
 
 // Time returns the Unix time in milliseconds encoded in the ULID.
@@ -357,19 +373,45 @@ func (id ULID) Time() uint64 {
 	return binary.BigEndian.Uint64(id[:8]) >> 16
 }
 
-// Timestamp converts a time.Time to Unix milliseconds.
+// Timestamp converts a time.Time to Unix milliseconds. Times before the
+// Unix epoch, which ULIDs cannot represent, are clamped to 0: this is the
+// escape hatch for callers that want to accept them, the functions taking
+// a time.Time failing with ErrNegativeTime instead.
 func Timestamp(t time.Time) uint64 {
-	return uint64(t.UnixMilli())
+	ms := t.UnixMilli()
+	if ms < 0 {
+		return 0
+	}
+	return uint64(ms)
+}
+
+// timestampOf returns the Unix milliseconds of t, failing with
+// ErrNegativeTime or ErrBigTime if t is outside the range of ULIDs.
+func timestampOf(t time.Time) (uint64, error) {
+	ms := t.UnixMilli()
+	switch {
+	case ms < 0:
+		return 0, ErrNegativeTime
+	case uint64(ms) > MaxTime:
+		return 0, ErrBigTime
+	}
+	return uint64(ms), nil
 }
 
 // Time converts Unix milliseconds in the format returned by the Timestamp
-// function to a time.Time.
+// function to a time.Time in the local time zone. See TimeUTC.
 func Time(ms uint64) time.Time {
 	s := int64(ms / 1e3)
 	ns := int64((ms % 1e3) * 1e6)
 	return time.Unix(s, ns)
 }
 
+// TimeUTC is like Time, but returns a time.Time in UTC, so formatting it
+// does not depend on the time zone of the server.
+func TimeUTC(ms uint64) time.Time {
+	return Time(ms).UTC()
+}
+
 // SetTime sets the time component of the ULID to the given Unix time
 // in milliseconds.
 func (id *ULID) SetTime(ms uint64) error {
@@ -377,21 +419,56 @@ func (id *ULID) SetTime(ms uint64) error {
 		return ErrBigTime
 	}
 
-	binary.BigEndian.PutUint64(id[:8], ms<<16)
+	// Seuls les 6 premiers octets : id[6:8] fait partie de l'entropie
+	id[0] = byte(ms >> 40)
+	id[1] = byte(ms >> 32)
+	id[2] = byte(ms >> 24)
+	id[3] = byte(ms >> 16)
+	id[4] = byte(ms >> 8)
+	id[5] = byte(ms)
 	return nil
 }
 
+// SetTimestamp sets the time component of the ULID to t. Unlike
+// SetTime(Timestamp(t)), it fails with ErrNegativeTime for times before the
+// Unix epoch instead of clamping them, and with ErrBigTime for times after
+// MaxTime.
+func (id *ULID) SetTimestamp(t time.Time) error {
+	ms, err := timestampOf(t)
+	if err != nil {
+		return err
+	}
+	return id.SetTime(ms)
+}
+
 // Entropy returns the entropy from the ULID.
 func (id ULID) Entropy() []byte {
-	e := make([]byte, 10)
+	e := make([]byte, EntropySize)
 	copy(e, id[6:])
 	return e
 }
 
+// MinEntropy returns the smallest entropy value, all zeros.
+func MinEntropy() [EntropySize]byte {
+	return [EntropySize]byte{}
+}
+
+// MaxEntropy returns the largest entropy value, all ones.
+func MaxEntropy() [EntropySize]byte {
+	return [EntropySize]byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF}
+}
+
+// ValidEntropy returns true if e can be used as the entropy of a ULID with
+// SetEntropy, i.e. if len(e) == EntropySize.
+func ValidEntropy(e []byte) bool {
+	return len(e) == EntropySize
+}
+
 // SetEntropy sets the ULID entropy to the passed byte slice.
-// ErrDataSize is returned if len(e) != 10.
+// ErrDataSize is returned if len(e) != EntropySize. See SetEntropyTruncate
+// for longer slices.
 func (id *ULID) SetEntropy(e []byte) error {
-	if len(e) != 10 {
+	if !ValidEntropy(e) {
 		return ErrDataSize
 	}
 
@@ -399,30 +476,77 @@ func (id *ULID) SetEntropy(e []byte) error {
 	return nil
 }
 
+// SetEntropyTruncate sets the ULID entropy to the first EntropySize bytes of
+// e, e.g. of a hash or of a larger random buffer. ErrDataSize is returned if
+// len(e) < EntropySize.
+func (id *ULID) SetEntropyTruncate(e []byte) error {
+	if len(e) < EntropySize {
+		return ErrDataSize
+	}
+	return id.SetEntropy(e[:EntropySize])
+}
+
+// EntropyArray returns the entropy of the ULID by value, without the heap
+// allocation of Entropy.
+func (id ULID) EntropyArray() [EntropySize]byte {
+	return [EntropySize]byte(id[6:])
+}
+
+// SetEntropyArray sets the ULID entropy to e.
+func (id *ULID) SetEntropyArray(e [EntropySize]byte) {
+	copy(id[6:], e[:])
+}
+
+// EntropyUint64s returns the 80 bits of entropy as big-endian integers: the
+// 16 high bits and the 64 low bits.
+func (id ULID) EntropyUint64s() (hi uint16, lo uint64) {
+	return binary.BigEndian.Uint16(id[6:8]), binary.BigEndian.Uint64(id[8:])
+}
+
 // Compare returns an integer comparing id and other lexicographically.
 // The result will be 0 if id==other, -1 if id < other, and +1 if id > other.
 func (id ULID) Compare(other ULID) int {
-	for i := 0; i < RawSize; i++ {
-		if id[i] < other[i] {
-			return -1
-		}
-		if id[i] > other[i] {
-			return 1
-		}
+	// Deux chargements 64 bits big-endian au lieu d'une boucle octet par octet
+	a, b := binary.BigEndian.Uint64(id[:8]), binary.BigEndian.Uint64(other[:8])
+	if a == b {
+		a, b = binary.BigEndian.Uint64(id[8:]), binary.BigEndian.Uint64(other[8:])
+	}
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
 	}
 	return 0
 }
 
 // Scan implements the sql.Scanner interface. It supports scanning
-// a string or byte slice.
+// a string or byte slice. A NULL value sets the zero ULID; use
+// sql.Null[ULID] to tell NULL apart from a stored zero ULID. Values
+// implementing driver.Valuer, such as the UUID types of some drivers, are
+// scanned from the value they return; other values implementing
+// fmt.Stringer from their string form.
 func (id *ULID) Scan(src interface{}) error {
 	switch x := src.(type) {
 	case nil:
+		*id = ULID{}
 		return nil
 	case string:
 		return id.UnmarshalText([]byte(x))
 	case []byte:
 		return id.UnmarshalText(x)
+	case driver.Valuer:
+		v, err := x.Value()
+		if err != nil {
+			return err
+		}
+		// Un seul niveau, pour ne pas boucler sur un Valuer qui se renvoie
+		switch v.(type) {
+		case nil, string, []byte:
+			return id.Scan(v)
+		}
+	case fmt.Stringer:
+		return id.UnmarshalText([]byte(x.String()))
 	}
 	return ErrScanValue
 }
@@ -490,12 +614,16 @@ var dec = [256]byte{
 	0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF,
 }
 
-// Monotonic entropy source
-type monoReader struct {
-	io.Reader
-	ms   uint64
-	inc  uint64
-	rand uint64
+// MonoReader is the monotonic entropy source returned by MonotonicReader
+// and NewMonoReader. Its accessors expose how close it is to ErrMonotonicOverflow, so that
+// long-lived readers can be monitored and replaced in time.
+//
+// A MonoReader is NOT safe for concurrent use.
+type MonoReader struct {
+	entropy io.Reader
+	ms      uint64
+	inc     uint64
+	rand    uint64
 }
 
 // MonotonicReader returns an io.Reader that generates monotonically increasing
@@ -503,15 +631,21 @@ type monoReader struct {
 // increment the previous entropy. If the timestamp is different, it will
 // generate new random entropy.
 //
-// The returned reader is NOT safe for concurrent use.
+// The returned reader is a *MonoReader and is NOT safe for concurrent use.
 func MonotonicReader(ms uint64, entropy io.Reader) io.Reader {
+	return NewMonoReader(ms, entropy)
+}
+
+// NewMonoReader is like MonotonicReader, but returns the concrete type so
+// that its state can be observed.
+func NewMonoReader(ms uint64, entropy io.Reader) *MonoReader {
 	if entropy == nil {
-		entropy = rand.Reader
+		entropy = defaultReader{}
 	}
 
-	m := &monoReader{
-		Reader: entropy,
-		ms:     ms,
+	m := &MonoReader{
+		entropy: entropy,
+		ms:      ms,
 	}
 
 	if err := binary.Read(entropy, binary.BigEndian, &m.rand); err != nil {
@@ -523,13 +657,13 @@ func MonotonicReader(ms uint64, entropy io.Reader) io.Reader {
 	return m
 }
 
-func (m *monoReader) Read(p []byte) (n int, err error) {
+func (m *MonoReader) Read(p []byte) (n int, err error) {
 	if len(p) != 10 {
 		return 0, ErrDataSize
 	}
 
 	if m.ms == 0 {
-		if err := binary.Read(m.Reader, binary.BigEndian, &m.rand); err != nil {
+		if err := binary.Read(m.entropy, binary.BigEndian, &m.rand); err != nil {
 			return 0, err
 		}
 		m.inc = m.rand
@@ -546,6 +680,31 @@ func (m *monoReader) Read(p []byte) (n int, err error) {
 	return 10, nil
 }
 
+// LastTimestamp returns the timestamp in milliseconds the reader was
+// created for. A reader created for 0 reads new entropy on every call.
+func (m *MonoReader) LastTimestamp() uint64 {
+	return m.ms
+}
+
+// Counter returns the number of increments since the entropy was last read
+// from the underlying source. It is meaningless once the reader returned
+// ErrMonotonicOverflow, see Remaining.
+func (m *MonoReader) Counter() uint64 {
+	return m.inc - m.rand
+}
+
+// Remaining returns the number of reads left before the reader returns
+// ErrMonotonicOverflow.
+func (m *MonoReader) Remaining() uint64 {
+	switch {
+	case m.ms == 0:
+		return math.MaxUint64
+	case m.inc < m.rand: // déjà débordé
+		return 0
+	}
+	return math.MaxUint64 - m.inc
+}
+
 // MonotonicEntropy is a convenience function that returns a MonotonicReader
 // for the given time.
 func MonotonicEntropy(t time.Time, entropy io.Reader) io.Reader {
@@ -585,6 +744,13 @@ func (id ULID) Equal(other ULID) bool {
 	return id == other
 }
 
+// EqualConstantTime returns true if a is equal to b, taking the same time
+// whatever the position of the first differing byte. Use it instead of
+// Equal or Compare when ULIDs act as secrets, such as password reset tokens.
+func EqualConstantTime(a, b ULID) bool {
+	return subtle.ConstantTimeCompare(a[:], b[:]) == 1
+}
+
 // LeadingZeros returns the number of leading zero bits in the ULID
 func (id ULID) LeadingZeros() int {
 	for i, b := range id {
@@ -616,11 +782,47 @@ func (id ULID) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON est maintenant Garanti 0 allocation.
+//
+// Following the encoding/json convention, a JSON null is a no-op. Other
+// invalid values return a *JSONError describing the problem.
 func (id *ULID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
 	// Vérification de taille exacte pour éviter les overheads
 	if len(data) != 28 || data[0] != '"' || data[27] != '"' {
-		return ErrDataSize
+		return jsonShapeError(data)
 	}
 	// On parse directement la tranche interne
-	return id.UnmarshalText(data[1:27])
+	if err := decode(id, data[1:27], true); err != nil {
+		return jsonCharsError(data[1:27], err)
+	}
+	return nil
+}
+
+// ZeroAsNull wraps a ULID so that the zero ULID marshals to JSON null and
+// JSON null unmarshals to the zero ULID, for optional ID fields. Every
+// other ULID method is promoted from the embedded ULID.
+//
+// For fields that should be left out instead, ULID works with the
+// omitzero struct tag option through its IsZero method.
+type ZeroAsNull struct {
+	ULID
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (z ZeroAsNull) MarshalJSON() ([]byte, error) {
+	if z.IsZero() {
+		return []byte("null"), nil
+	}
+	return z.ULID.MarshalJSON()
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (z *ZeroAsNull) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		z.ULID = ULID{}
+		return nil
+	}
+	return z.ULID.UnmarshalJSON(data)
 }
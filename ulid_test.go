@@ -5,6 +5,8 @@ import (
 	"crypto/rand"
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 )
@@ -103,6 +105,30 @@ func TestParse(t *testing.T) {
 	}
 }
 
+func TestParseInvalidEntropy(t *testing.T) {
+	const valid = "01AN4Z07BY79KA1307SR9X4MV3"
+	want := MustParse(valid).Time()
+	for i := 10; i < EncodedSize; i++ {
+		s := valid[:i] + "U" + valid[i+1:]
+		id, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", s, err)
+		}
+		if id.Time() != want {
+			t.Errorf("Parse(%q).Time() = %d, want %d", s, id.Time(), want)
+		}
+		// Le caractère invalide ne touche que ses propres bits
+		if z := MustParse(valid[:i] + "Z" + valid[i+1:]); id != z {
+			t.Errorf("Parse(%q) = %v, want %v", s, id, z)
+		}
+
+		var u ULID
+		if err := u.UnmarshalText([]byte(s)); err != nil || u != id {
+			t.Errorf("UnmarshalText(%q) = %v, %v, want %v", s, u, err, id)
+		}
+	}
+}
+
 func TestParseStrict(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -214,6 +240,52 @@ func TestMarshalJSON(t *testing.T) {
 	}
 }
 
+func TestUnmarshalJSONNull(t *testing.T) {
+	id := Make()
+	want := id
+	if err := json.Unmarshal([]byte("null"), &id); err != nil {
+		t.Fatalf("json.Unmarshal(null) error = %v", err)
+	}
+	if id != want {
+		t.Errorf("json.Unmarshal(null) = %v, want unchanged %v", id, want)
+	}
+}
+
+func TestZeroAsNull(t *testing.T) {
+	type payload struct {
+		ID     ZeroAsNull `json:"id"`
+		Parent ULID       `json:"parent,omitzero"`
+	}
+
+	data, err := json.Marshal(payload{})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(data) != `{"id":null}` {
+		t.Errorf("json.Marshal() = %s, want {\"id\":null}", data)
+	}
+
+	p := payload{ID: ZeroAsNull{Make()}}
+	data, err = json.Marshal(p)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	var p2 payload
+	if err := json.Unmarshal(data, &p2); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	if p2.ID != p.ID {
+		t.Errorf("json.Unmarshal(json.Marshal()) = %v, want %v", p2.ID, p.ID)
+	}
+
+	if err := json.Unmarshal([]byte(`{"id":null}`), &p2); err != nil {
+		t.Fatalf("json.Unmarshal(null) error = %v", err)
+	}
+	if !p2.ID.IsZero() {
+		t.Errorf("json.Unmarshal(null) = %v, want zero", p2.ID)
+	}
+}
+
 func TestTime(t *testing.T) {
 	now := time.Now()
 	ms := Timestamp(now)
@@ -256,6 +328,55 @@ func TestSetTime(t *testing.T) {
 	if err := id.SetTime(MaxTime + 1); err != ErrBigTime {
 		t.Errorf("SetTime(MaxTime+1) error = %v, want %v", err, ErrBigTime)
 	}
+
+	// The entropy is left untouched
+	e := id.EntropyArray()
+	_ = id.SetTime(42)
+	if id.EntropyArray() != e {
+		t.Errorf("SetTime() changed entropy to %x, want %x", id.EntropyArray(), e)
+	}
+}
+
+func TestSetTimestamp(t *testing.T) {
+	id := Make()
+	e := id.EntropyArray()
+
+	now := time.Now()
+	if err := id.SetTimestamp(now); err != nil || id.Time() != uint64(now.UnixMilli()) {
+		t.Errorf("SetTimestamp(now) = %v, %v, want %v", id.Time(), err, now.UnixMilli())
+	}
+	if id.EntropyArray() != e {
+		t.Errorf("SetTimestamp() changed entropy to %x, want %x", id.EntropyArray(), e)
+	}
+
+	before := id
+	for _, tt := range []struct {
+		t   time.Time
+		err error
+	}{
+		{time.Time{}, ErrNegativeTime},
+		{time.UnixMilli(-1), ErrNegativeTime},
+		{Time(MaxTime).Add(time.Millisecond), ErrBigTime},
+	} {
+		if err := id.SetTimestamp(tt.t); err != tt.err {
+			t.Errorf("SetTimestamp(%v) error = %v, want %v", tt.t, err, tt.err)
+		}
+	}
+	if id != before {
+		t.Errorf("SetTimestamp() failure changed %v to %v", before, id)
+	}
+}
+
+func TestTimestampClamp(t *testing.T) {
+	if got := Timestamp(time.Time{}); got != 0 {
+		t.Errorf("Timestamp(zero time) = %v, want 0", got)
+	}
+	if got := Timestamp(time.UnixMilli(-1)); got != 0 {
+		t.Errorf("Timestamp(-1ms) = %v, want 0", got)
+	}
+	if got := Timestamp(time.UnixMilli(1000)); got != 1000 {
+		t.Errorf("Timestamp(1s) = %v, want 1000", got)
+	}
 }
 
 func TestEntropy(t *testing.T) {
@@ -309,6 +430,19 @@ func TestCompare(t *testing.T) {
 	}
 }
 
+func TestEqualConstantTime(t *testing.T) {
+	id := Make()
+	other := id
+	other[RawSize-1] ^= 1
+
+	if !EqualConstantTime(id, id) {
+		t.Error("EqualConstantTime(self) should return true")
+	}
+	if EqualConstantTime(id, other) {
+		t.Error("EqualConstantTime() should return false for different ULIDs")
+	}
+}
+
 func TestScan(t *testing.T) {
 	id := Make()
 	str := id.String()
@@ -338,6 +472,31 @@ func TestScan(t *testing.T) {
 			input:   123,
 			wantErr: true,
 		},
+		{
+			name:    "valuer",
+			input:   testValuer{str},
+			wantErr: false,
+		},
+		{
+			name:    "ULID valuer",
+			input:   id,
+			wantErr: false,
+		},
+		{
+			name:    "stringer",
+			input:   testStringer(str),
+			wantErr: false,
+		},
+		{
+			name:    "nested valuer",
+			input:   testValuer{testValuer{str}},
+			wantErr: true,
+		},
+		{
+			name:    "failing valuer",
+			input:   testValuer{errors.New("boom")},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -355,6 +514,19 @@ func TestScan(t *testing.T) {
 	}
 }
 
+type testValuer struct{ v interface{} }
+
+func (v testValuer) Value() (driver.Value, error) {
+	if err, ok := v.v.(error); ok {
+		return nil, err
+	}
+	return v.v, nil
+}
+
+type testStringer string
+
+func (s testStringer) String() string { return string(s) }
+
 func TestValue(t *testing.T) {
 	id := Make()
 	val, err := id.Value()
@@ -417,6 +589,32 @@ func TestMonotonicReader(t *testing.T) {
 	}
 }
 
+func TestMonoReaderState(t *testing.T) {
+	seed := []byte{0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFF, 0xFD}
+	m := NewMonoReader(42, bytes.NewReader(seed))
+	if m.LastTimestamp() != 42 || m.Counter() != 0 || m.Remaining() != 2 {
+		t.Fatalf("state = %d, %d, %d, want 42, 0, 2", m.LastTimestamp(), m.Counter(), m.Remaining())
+	}
+
+	for i := 1; i <= 2; i++ {
+		if _, err := New(42, m); err != nil {
+			t.Fatalf("New() error = %v", err)
+		}
+		if m.Counter() != uint64(i) || m.Remaining() != uint64(2-i) {
+			t.Errorf("Counter(), Remaining() = %d, %d, want %d, %d", m.Counter(), m.Remaining(), i, 2-i)
+		}
+	}
+	if _, err := New(42, m); err != ErrMonotonicOverflow {
+		t.Errorf("New() error = %v, want %v", err, ErrMonotonicOverflow)
+	}
+	if m.Remaining() != 0 {
+		t.Errorf("Remaining() after overflow = %d, want 0", m.Remaining())
+	}
+	if _, ok := MonotonicReader(42, nil).(*MonoReader); !ok {
+		t.Error("MonotonicReader() is not a *MonoReader")
+	}
+}
+
 func TestLeadingZeros(t *testing.T) {
 	var zero ULID
 	if zero.LeadingZeros() != 128 {
@@ -498,6 +696,7 @@ func BenchmarkMake(b *testing.B) {
 func BenchmarkParse(b *testing.B) {
 	id := Make()
 	str := id.String()
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -526,6 +725,7 @@ func BenchmarkMarshalText(b *testing.B) {
 func BenchmarkUnmarshalText(b *testing.B) {
 	id := Make()
 	data, _ := id.MarshalText()
+	b.ReportAllocs()
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
@@ -534,6 +734,25 @@ func BenchmarkUnmarshalText(b *testing.B) {
 	}
 }
 
+func BenchmarkParseInvalid(b *testing.B) {
+	str := "01AN4Z07BY79KA1307SR9X4M!V"
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseStrict(str)
+	}
+}
+
+func BenchmarkUnmarshalTextInvalid(b *testing.B) {
+	data := []byte("81AN4Z07BY79KA1307SR9X4MV3")
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var id ULID
+		_ = id.UnmarshalText(data)
+	}
+}
+
 func BenchmarkMarshalJSON(b *testing.B) {
 	id := Make()
 	b.ResetTimer()
@@ -553,3 +772,188 @@ func BenchmarkUnmarshalJSON(b *testing.B) {
 		_ = json.Unmarshal(data, &id2)
 	}
 }
+
+func TestMustParse(t *testing.T) {
+	id := Make()
+	if got := MustParse(id.String()); got != id {
+		t.Errorf("MustParse() = %v, want %v", got, id)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParse(invalid) should panic")
+		}
+	}()
+	MustParse("01AN4Z07BY79KA1307SR9X4MVU")
+}
+
+func TestGoString(t *testing.T) {
+	id := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	v := struct{ ID ULID }{id}
+
+	want := `struct { ID ulid.ULID }{ID:ulid.MustParse("01AN4Z07BY79KA1307SR9X4MV3")}`
+	if got := fmt.Sprintf("%#v", v); got != want {
+		t.Errorf("%%#v = %s, want %s", got, want)
+	}
+}
+
+func TestStringArray(t *testing.T) {
+	id := Make()
+	a := id.StringArray()
+	if string(a[:]) != id.String() {
+		t.Errorf("StringArray() = %s, want %s", a[:], id.String())
+	}
+	if n := testing.AllocsPerRun(100, func() { a = id.StringArray() }); n != 0 {
+		t.Errorf("StringArray() allocs = %v, want 0", n)
+	}
+}
+
+func TestEntropyArray(t *testing.T) {
+	id := Make()
+	e := id.EntropyArray()
+	if !bytes.Equal(e[:], id.Entropy()) {
+		t.Errorf("EntropyArray() = %x, want %x", e, id.Entropy())
+	}
+
+	var id2 ULID
+	id2.SetEntropyArray(e)
+	if !bytes.Equal(id2.Entropy(), id.Entropy()) || id2.Time() != 0 {
+		t.Errorf("SetEntropyArray() = %v", id2)
+	}
+
+	id3 := MustNew(1, bytes.NewReader([]byte{0x01, 0x02, 0, 0, 0, 0, 0, 0, 0x03, 0x04}))
+	hi, lo := id3.EntropyUint64s()
+	if hi != 0x0102 || lo != 0x0304 {
+		t.Errorf("EntropyUint64s() = %#x, %#x, want 0x102, 0x304", hi, lo)
+	}
+
+	if n := testing.AllocsPerRun(100, func() { e = id.EntropyArray() }); n != 0 {
+		t.Errorf("EntropyArray() allocs = %v, want 0", n)
+	}
+}
+
+func TestFromParts(t *testing.T) {
+	id := Make()
+	got, err := FromParts(id.Time(), id.EntropyArray())
+	if err != nil || got != id {
+		t.Errorf("FromParts() = %v, %v, want %v", got, err, id)
+	}
+
+	if _, err := FromParts(MaxTime+1, [10]byte{}); err != ErrBigTime {
+		t.Errorf("FromParts(MaxTime+1) error = %v, want %v", err, ErrBigTime)
+	}
+}
+
+func TestMarshalInto(t *testing.T) {
+	id := Make()
+	buf := make([]byte, 64)
+
+	n, err := id.MarshalTextInto(buf)
+	if err != nil || n != EncodedSize || string(buf[:n]) != id.String() {
+		t.Errorf("MarshalTextInto() = %d, %v, %q", n, err, buf[:n])
+	}
+	n, err = id.MarshalBinaryInto(buf)
+	if err != nil || n != RawSize || !bytes.Equal(buf[:n], id[:]) {
+		t.Errorf("MarshalBinaryInto() = %d, %v, %x", n, err, buf[:n])
+	}
+
+	if _, err := id.MarshalTextInto(buf[:EncodedSize-1]); err != ErrBufferSize {
+		t.Errorf("MarshalTextInto(short) error = %v, want %v", err, ErrBufferSize)
+	}
+	if _, err := id.MarshalBinaryInto(buf[:RawSize-1]); err != ErrBufferSize {
+		t.Errorf("MarshalBinaryInto(short) error = %v, want %v", err, ErrBufferSize)
+	}
+}
+
+func BenchmarkParseStrict(b *testing.B) {
+	str := Make().String()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseStrict(str)
+	}
+}
+
+func TestParseAllocs(t *testing.T) {
+	for _, s := range []string{
+		Make().String(),
+		"01AN4Z07BY79KA1307SR9X4M",
+		"01AN4Z07BY79KA1307SR9X4M!V",
+		"81AN4Z07BY79KA1307SR9X4MV3",
+	} {
+		var id ULID
+		data := []byte(s)
+		if n := testing.AllocsPerRun(100, func() { _, _ = Parse(s) }); n != 0 {
+			t.Errorf("Parse(%q) allocs = %v, want 0", s, n)
+		}
+		if n := testing.AllocsPerRun(100, func() { _, _ = ParseStrict(s) }); n != 0 {
+			t.Errorf("ParseStrict(%q) allocs = %v, want 0", s, n)
+		}
+		if n := testing.AllocsPerRun(100, func() { _ = id.UnmarshalText(data) }); n != 0 {
+			t.Errorf("UnmarshalText(%q) allocs = %v, want 0", s, n)
+		}
+	}
+}
+
+func TestNegativeTime(t *testing.T) {
+	neg := time.UnixMilli(-1)
+
+	if _, err := New(uint64(neg.UnixMilli()), nil); err != ErrNegativeTime {
+		t.Errorf("New(negative) error = %v, want %v", err, ErrNegativeTime)
+	}
+	if _, err := New(MaxTime+1, nil); err != ErrBigTime {
+		t.Errorf("New(MaxTime+1) error = %v, want %v", err, ErrBigTime)
+	}
+	if _, err := NewGenerator().NewWithTime(time.Time{}); err != ErrNegativeTime {
+		t.Errorf("Generator.NewWithTime(zero time) error = %v, want %v", err, ErrNegativeTime)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != ErrNegativeTime {
+				t.Errorf("MakeWithTime(zero time) panic = %v, want %v", r, ErrNegativeTime)
+			}
+		}()
+		MakeWithTime(time.Time{})
+	}()
+
+	// Clamping through Timestamp is the documented escape hatch
+	if id := MustNew(Timestamp(neg), nil); id.Time() != 0 {
+		t.Errorf("MustNew(Timestamp(negative)).Time() = %v, want 0", id.Time())
+	}
+}
+
+func TestEntropyContract(t *testing.T) {
+	if EntropySize != 10 {
+		t.Errorf("EntropySize = %v, want 10", EntropySize)
+	}
+
+	id := MustNew(1, nil)
+	id.SetEntropyArray(MaxEntropy())
+	if id.String() != "0000000001ZZZZZZZZZZZZZZZZ" {
+		t.Errorf("MaxEntropy gives %v, want 0000000001ZZZZZZZZZZZZZZZZ", id)
+	}
+	id.SetEntropyArray(MinEntropy())
+	if id != MustNew(1, bytes.NewReader(make([]byte, EntropySize))) {
+		t.Errorf("MinEntropy gives %v, want zero entropy", id)
+	}
+
+	for n, want := range map[int]bool{0: false, 9: false, 10: true, 11: false} {
+		if got := ValidEntropy(make([]byte, n)); got != want {
+			t.Errorf("ValidEntropy(%d bytes) = %v, want %v", n, got, want)
+		}
+	}
+
+	long := []byte("0123456789abcdef")
+	if err := id.SetEntropy(long); err != ErrDataSize {
+		t.Errorf("SetEntropy(16 bytes) error = %v, want %v", err, ErrDataSize)
+	}
+	if err := id.SetEntropyTruncate(long); err != nil || string(id.Entropy()) != "0123456789" {
+		t.Errorf("SetEntropyTruncate(16 bytes) = %q, %v, want 0123456789", id.Entropy(), err)
+	}
+	if err := id.SetEntropyTruncate(long[:9]); err != ErrDataSize {
+		t.Errorf("SetEntropyTruncate(9 bytes) error = %v, want %v", err, ErrDataSize)
+	}
+	if id.Time() != 1 {
+		t.Errorf("SetEntropyTruncate() changed time to %v", id.Time())
+	}
+}
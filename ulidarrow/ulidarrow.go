@@ -0,0 +1,112 @@
+// Package ulidarrow converts ULIDs to and from Arrow FixedSizeBinary(16)
+// arrays, keeping IDs compact and byte-wise sortable in analytics exports
+// (Arrow IPC, Parquet) instead of storing them as 26 characters strings.
+package ulidarrow
+
+import (
+	"errors"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/kamalshkeir/ulid"
+)
+
+// ErrType is returned when converting an array whose type is not
+// FixedSizeBinary(16)
+var ErrType = errors.New("ulidarrow: array type is not fixed_size_binary[16]")
+
+// Type is the Arrow data type of ULID columns.
+var Type = &arrow.FixedSizeBinaryType{ByteWidth: ulid.RawSize}
+
+// Field returns a field of type Type named name.
+func Field(name string, nullable bool) arrow.Field {
+	return arrow.Field{Name: name, Type: Type, Nullable: nullable}
+}
+
+// NewBuilder returns a builder of ULID arrays.
+func NewBuilder(mem memory.Allocator) *array.FixedSizeBinaryBuilder {
+	return array.NewFixedSizeBinaryBuilder(mem, Type)
+}
+
+// Append appends id to b, which must have been created by NewBuilder.
+func Append(b *array.FixedSizeBinaryBuilder, id ulid.ULID) {
+	b.Append(id[:])
+}
+
+// NewArray returns an array holding ids. The caller must Release it.
+func NewArray(mem memory.Allocator, ids []ulid.ULID) *array.FixedSizeBinary {
+	b := NewBuilder(mem)
+	defer b.Release()
+
+	b.Reserve(len(ids))
+	for _, id := range ids {
+		b.Append(id[:])
+	}
+	return b.NewFixedSizeBinaryArray()
+}
+
+// FromArray returns the ULIDs stored in arr. Null values are returned as
+// zero ULIDs.
+func FromArray(arr arrow.Array) ([]ulid.ULID, error) {
+	a, err := fixed(arr)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]ulid.ULID, a.Len())
+	for i := range ids {
+		if a.IsValid(i) {
+			ids[i] = ulid.ULID(a.Value(i))
+		}
+	}
+	return ids, nil
+}
+
+// Stats holds the statistics of a ULID column.
+type Stats struct {
+	// Min and Max are the smallest and greatest non null ULIDs
+	Min, Max ulid.ULID
+
+	// Count is the number of values, NullCount the number of null ones
+	Count, NullCount int
+}
+
+// HasMinMax returns true if the column has at least one non null value.
+func (s Stats) HasMinMax() bool {
+	return s.Count > s.NullCount
+}
+
+// ColumnStats computes the statistics of arr. Min and Max can be written as
+// the Parquet min/max statistics of the column with their Bytes method.
+func ColumnStats(arr arrow.Array) (Stats, error) {
+	a, err := fixed(arr)
+	if err != nil {
+		return Stats{}, err
+	}
+
+	s := Stats{Count: a.Len(), NullCount: a.NullN()}
+	first := true
+	for i := 0; i < a.Len(); i++ {
+		if a.IsNull(i) {
+			continue
+		}
+		id := ulid.ULID(a.Value(i))
+		if first || id.Compare(s.Min) < 0 {
+			s.Min = id
+		}
+		if first || id.Compare(s.Max) > 0 {
+			s.Max = id
+		}
+		first = false
+	}
+	return s, nil
+}
+
+func fixed(arr arrow.Array) (*array.FixedSizeBinary, error) {
+	a, ok := arr.(*array.FixedSizeBinary)
+	if !ok || !arrow.TypeEqual(a.DataType(), Type) {
+		return nil, ErrType
+	}
+	return a, nil
+}
@@ -0,0 +1,89 @@
+package ulidarrow
+
+import (
+	"testing"
+
+	"github.com/apache/arrow-go/v18/arrow"
+	"github.com/apache/arrow-go/v18/arrow/array"
+	"github.com/apache/arrow-go/v18/arrow/memory"
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestRoundTrip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+
+	ids := []ulid.ULID{ulid.MustNew(3, nil), ulid.MustNew(1, nil), ulid.MustNew(2, nil)}
+	arr := NewArray(mem, ids)
+	defer arr.Release()
+
+	if !arrow.TypeEqual(arr.DataType(), Type) {
+		t.Errorf("DataType() = %v, want %v", arr.DataType(), Type)
+	}
+
+	got, err := FromArray(arr)
+	if err != nil {
+		t.Fatalf("FromArray() error = %v", err)
+	}
+	for i := range ids {
+		if got[i] != ids[i] {
+			t.Errorf("FromArray()[%d] = %v, want %v", i, got[i], ids[i])
+		}
+	}
+
+	s, err := ColumnStats(arr)
+	if err != nil {
+		t.Fatalf("ColumnStats() error = %v", err)
+	}
+	if s.Min != ids[1] || s.Max != ids[0] || s.Count != 3 || s.NullCount != 0 || !s.HasMinMax() {
+		t.Errorf("ColumnStats() = %+v", s)
+	}
+}
+
+func TestNulls(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+
+	id := ulid.Make()
+	b := NewBuilder(mem)
+	defer b.Release()
+	b.AppendNull()
+	Append(b, id)
+	arr := b.NewFixedSizeBinaryArray()
+	defer arr.Release()
+
+	got, err := FromArray(arr)
+	if err != nil {
+		t.Fatalf("FromArray() error = %v", err)
+	}
+	if !got[0].IsZero() || got[1] != id {
+		t.Errorf("FromArray() = %v", got)
+	}
+
+	s, _ := ColumnStats(arr)
+	if s.Min != id || s.Max != id || s.NullCount != 1 {
+		t.Errorf("ColumnStats() = %+v", s)
+	}
+
+	b.AppendNull()
+	empty := b.NewFixedSizeBinaryArray()
+	defer empty.Release()
+	if s, _ := ColumnStats(empty); s.HasMinMax() {
+		t.Errorf("ColumnStats(all nulls).HasMinMax() = true")
+	}
+}
+
+func TestType(t *testing.T) {
+	sb := array.NewStringBuilder(memory.DefaultAllocator)
+	defer sb.Release()
+	sb.Append(ulid.Make().String())
+	arr := sb.NewArray()
+	defer arr.Release()
+
+	if _, err := FromArray(arr); err != ErrType {
+		t.Errorf("FromArray(string) error = %v, want %v", err, ErrType)
+	}
+	if _, err := ColumnStats(arr); err != ErrType {
+		t.Errorf("ColumnStats(string) error = %v, want %v", err, ErrType)
+	}
+}
@@ -0,0 +1,97 @@
+// Package ulidgrpc provides gRPC interceptors generating or propagating a
+// ULID request ID through metadata, mirroring the ulidhttp middleware.
+package ulidgrpc
+
+import (
+	"context"
+
+	"github.com/kamalshkeir/ulid"
+	"github.com/kamalshkeir/ulid/internal/reqctx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the gRPC metadata key carrying the request ID
+const MetadataKey = "x-request-id"
+
+// NewContext returns a copy of ctx carrying the request ID id. The context
+// is shared with ulidhttp, so an ID assigned by the HTTP middleware is
+// propagated by the client interceptors.
+func NewContext(ctx context.Context, id ulid.ULID) context.Context {
+	return reqctx.NewContext(ctx, id)
+}
+
+// FromContext returns the request ID stored in ctx, if any.
+func FromContext(ctx context.Context) (ulid.ULID, bool) {
+	return reqctx.FromContext(ctx)
+}
+
+// serverID returns the request ID of an incoming call: the one found in
+// the incoming metadata when it is a valid ULID, otherwise a new one.
+func serverID(ctx context.Context) (context.Context, ulid.ULID) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(MetadataKey); len(v) > 0 {
+			if id, err := ulid.ParseStrict(v[0]); err == nil {
+				return NewContext(ctx, id), id
+			}
+		}
+	}
+	id := ulid.Make()
+	return NewContext(ctx, id), id
+}
+
+// clientContext adds the request ID of ctx, or a new one, to the outgoing
+// metadata.
+func clientContext(ctx context.Context) context.Context {
+	id, ok := FromContext(ctx)
+	if !ok {
+		id = ulid.Make()
+		ctx = NewContext(ctx, id)
+	}
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, id.String())
+}
+
+// UnaryServerInterceptor returns a server interceptor storing the request
+// ID in the handler context and sending it back in the response header.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, id := serverID(ctx)
+		_ = grpc.SetHeader(ctx, metadata.Pairs(MetadataKey, id.String()))
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns the streaming counterpart of
+// UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, id := serverID(ss.Context())
+		_ = ss.SetHeader(metadata.Pairs(MetadataKey, id.String()))
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// UnaryClientInterceptor returns a client interceptor sending the request
+// ID of the call context, or a new one, in the outgoing metadata.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(clientContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor returns the streaming counterpart of
+// UnaryClientInterceptor.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(clientContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,142 @@
+package ulidgrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+
+	var got ulid.ULID
+	handler := func(ctx context.Context, req any) (any, error) {
+		got, _ = FromContext(ctx)
+		return nil, nil
+	}
+
+	// New ID
+	if _, err := interceptor(context.Background(), nil, nil, handler); err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if got.IsZero() {
+		t.Fatal("interceptor did not assign a request ID")
+	}
+
+	// Incoming ID is honored
+	want := ulid.Make()
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, want.String()))
+	if _, err := interceptor(ctx, nil, nil, handler); err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if got != want {
+		t.Errorf("request ID = %v, want incoming %v", got, want)
+	}
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	interceptor := UnaryClientInterceptor()
+
+	var sent string
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if v := md.Get(MetadataKey); len(v) > 0 {
+			sent = v[0]
+		}
+		return nil
+	}
+
+	want := ulid.Make()
+	ctx := NewContext(context.Background(), want)
+	if err := interceptor(ctx, "/svc/method", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if sent != want.String() {
+		t.Errorf("sent request ID = %v, want %v", sent, want)
+	}
+
+	sent = ""
+	if err := interceptor(context.Background(), "/svc/method", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if _, err := ulid.ParseStrict(sent); err != nil {
+		t.Errorf("sent request ID = %q, want a new ULID", sent)
+	}
+}
+
+// testServerStream records the header sent by the interceptor.
+type testServerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *testServerStream) Context() context.Context { return s.ctx }
+
+func (s *testServerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	interceptor := StreamServerInterceptor()
+
+	var got ulid.ULID
+	handler := func(srv any, ss grpc.ServerStream) error {
+		got, _ = FromContext(ss.Context())
+		return nil
+	}
+
+	want := ulid.Make()
+	ss := &testServerStream{ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(MetadataKey, want.String()))}
+	if err := interceptor(nil, ss, nil, handler); err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if got != want {
+		t.Errorf("request ID = %v, want incoming %v", got, want)
+	}
+	if v := ss.header.Get(MetadataKey); len(v) != 1 || v[0] != want.String() {
+		t.Errorf("header = %v, want %v", v, want)
+	}
+
+	// New ID
+	ss = &testServerStream{ctx: context.Background()}
+	if err := interceptor(nil, ss, nil, handler); err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if got.IsZero() || got == want {
+		t.Errorf("request ID = %v, want a new ULID", got)
+	}
+}
+
+func TestStreamClientInterceptor(t *testing.T) {
+	interceptor := StreamClientInterceptor()
+
+	var sent string
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if v := md.Get(MetadataKey); len(v) > 0 {
+			sent = v[0]
+		}
+		return nil, nil
+	}
+
+	want := ulid.Make()
+	if _, err := interceptor(NewContext(context.Background(), want), nil, nil, "/svc/stream", streamer); err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if sent != want.String() {
+		t.Errorf("sent request ID = %v, want %v", sent, want)
+	}
+
+	sent = ""
+	if _, err := interceptor(context.Background(), nil, nil, "/svc/stream", streamer); err != nil {
+		t.Fatalf("interceptor error = %v", err)
+	}
+	if _, err := ulid.ParseStrict(sent); err != nil {
+		t.Errorf("sent request ID = %q, want a new ULID", sent)
+	}
+}
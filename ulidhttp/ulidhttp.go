@@ -0,0 +1,40 @@
+// Package ulidhttp provides HTTP middleware assigning a ULID request ID to
+// every request.
+package ulidhttp
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/kamalshkeir/ulid"
+	"github.com/kamalshkeir/ulid/internal/reqctx"
+)
+
+// Header is the HTTP header carrying the request ID
+const Header = "X-Request-ID"
+
+// NewContext returns a copy of ctx carrying the request ID id. The context
+// is shared with ulidgrpc.
+func NewContext(ctx context.Context, id ulid.ULID) context.Context {
+	return reqctx.NewContext(ctx, id)
+}
+
+// FromContext returns the request ID stored in ctx, if any.
+func FromContext(ctx context.Context) (ulid.ULID, bool) {
+	return reqctx.FromContext(ctx)
+}
+
+// Middleware assigns a request ID to every request before calling next.
+// An incoming X-Request-ID header is reused when it is a valid ULID,
+// otherwise a new ULID is generated. The ID is stored in the request
+// context, retrievable with FromContext, and set on the response header.
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := ulid.ParseStrict(r.Header.Get(Header))
+		if err != nil {
+			id = ulid.Make()
+		}
+		w.Header().Set(Header, id.String())
+		next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), id)))
+	})
+}
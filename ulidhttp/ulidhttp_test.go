@@ -0,0 +1,47 @@
+package ulidhttp
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestMiddleware(t *testing.T) {
+	var got ulid.ULID
+	h := Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, ok := FromContext(r.Context())
+		if !ok {
+			t.Error("FromContext() found no request ID")
+		}
+		got = id
+	}))
+
+	// New ID
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+	if got.IsZero() {
+		t.Fatal("Middleware() did not assign a request ID")
+	}
+	if rec.Header().Get(Header) != got.String() {
+		t.Errorf("response header = %v, want %v", rec.Header().Get(Header), got)
+	}
+
+	// Incoming valid ID is honored
+	want := ulid.Make()
+	req := httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(Header, want.String())
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	if got != want {
+		t.Errorf("request ID = %v, want incoming %v", got, want)
+	}
+
+	// Incoming invalid ID is replaced
+	req = httptest.NewRequest("GET", "/", nil)
+	req.Header.Set(Header, "not-a-ulid")
+	h.ServeHTTP(httptest.NewRecorder(), req)
+	if got.IsZero() || got == want {
+		t.Errorf("request ID = %v, want a new ULID", got)
+	}
+}
@@ -0,0 +1,407 @@
+// Package ulidlog stores append-only streams of ULIDs on disk in a dense
+// binary format, 16 bytes per ULID, with periodic index blocks allowing to
+// seek to a point in time without reading the whole file.
+//
+// A log file starts with a 16 bytes header, followed by blocks of BlockLen
+// ULIDs. Each complete block is followed by a 16 bytes index record holding
+// the min and max timestamps of the block and a CRC-32 of its content:
+//
+//	header | id * BlockLen | index | id * BlockLen | index | ... | id * n
+//
+// Seek relies on the ULIDs being appended in time order, as they are when a
+// single process logs the IDs it issues.
+package ulidlog
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"hash"
+	"hash/crc32"
+	"io"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+// BlockLen is the number of ULIDs between two index records.
+const BlockLen = 256
+
+const (
+	slotSize  = ulid.RawSize
+	blockSize = (BlockLen + 1) * slotSize // ULIDs and their index record
+	version   = 1
+)
+
+var magic = [8]byte{'U', 'L', 'I', 'D', 'L', 'O', 'G', 0}
+
+var (
+	// ErrFormat is returned when a file is not a ULID log
+	ErrFormat = errors.New("ulidlog: invalid file format")
+
+	// ErrCorrupt is returned when a block does not match its index record
+	ErrCorrupt = errors.New("ulidlog: corrupt block")
+)
+
+func header() []byte {
+	h := make([]byte, slotSize)
+	copy(h, magic[:])
+	h[len(magic)] = version
+	return h
+}
+
+func checkHeader(h []byte) error {
+	if len(h) != slotSize || [8]byte(h[:8]) != magic || h[8] != version {
+		return ErrFormat
+	}
+	return nil
+}
+
+// index is the record written after each complete block.
+type index struct {
+	min, max uint64
+	crc      uint32
+}
+
+func (x index) appendTo(b []byte) []byte {
+	var id ulid.ULID
+	_ = id.SetTime(x.min)
+	b = append(b, id[:6]...)
+	_ = id.SetTime(x.max)
+	b = append(b, id[:6]...)
+	return binary.BigEndian.AppendUint32(b, x.crc)
+}
+
+func parseIndex(b []byte) index {
+	var id ulid.ULID
+	copy(id[:6], b[:6])
+	min := id.Time()
+	copy(id[:6], b[6:12])
+	return index{min: min, max: id.Time(), crc: binary.BigEndian.Uint32(b[12:16])}
+}
+
+// count returns the number of ULIDs stored in a file of the given size,
+// ignoring a torn trailing record, and the number of blocks followed by
+// their index record. A trailing block of BlockLen ULIDs whose index was
+// not written, after a crash, is not indexed.
+func count(size int64) (n, indexed int64) {
+	slots := (size - slotSize) / slotSize
+	if slots <= 0 {
+		return 0, 0
+	}
+	indexed, rem := slots/(BlockLen+1), slots%(BlockLen+1)
+	return indexed*BlockLen + rem, indexed
+}
+
+// offset returns the position of the i-th ULID of the file.
+func offset(i int64) int64 {
+	return slotSize + i/BlockLen*blockSize + i%BlockLen*slotSize
+}
+
+// Writer appends ULIDs to a log file.
+//
+// A Writer is NOT safe for concurrent use.
+type Writer struct {
+	f   *os.File
+	w   *bufio.Writer
+	n   int // ULIDs in the current block
+	idx index
+	crc hash.Hash32
+}
+
+// Create opens the log file name for appending, creating it if needed.
+// A record left incomplete by a crash is discarded.
+func Create(name string) (*Writer, error) {
+	f, err := os.OpenFile(name, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	w, err := newWriter(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func newWriter(f *os.File) (*Writer, error) {
+	w := &Writer{f: f, crc: crc32.NewIEEE()}
+
+	st, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := st.Size()
+	if size < slotSize {
+		if err := f.Truncate(0); err != nil {
+			return nil, err
+		}
+		if _, err := f.WriteAt(header(), 0); err != nil {
+			return nil, err
+		}
+		size = slotSize
+	} else {
+		h := make([]byte, slotSize)
+		if _, err := f.ReadAt(h, 0); err != nil {
+			return nil, err
+		}
+		if err := checkHeader(h); err != nil {
+			return nil, err
+		}
+	}
+
+	// Reprend le bloc en cours pour pouvoir écrire son index
+	slots := (size - slotSize) / slotSize
+	end := slotSize + slots*slotSize
+	if err := f.Truncate(end); err != nil {
+		return nil, err
+	}
+	if rem := slots % (BlockLen + 1); rem > 0 {
+		buf := make([]byte, rem*slotSize)
+		if _, err := f.ReadAt(buf, end-int64(len(buf))); err != nil {
+			return nil, err
+		}
+		for b := buf; len(b) > 0; b = b[slotSize:] {
+			w.add(ulid.ULID(b[:slotSize]))
+		}
+	}
+
+	if _, err := f.Seek(end, io.SeekStart); err != nil {
+		return nil, err
+	}
+	w.w = bufio.NewWriterSize(f, blockSize)
+	if w.n == BlockLen {
+		if err := w.writeIndex(); err != nil {
+			return nil, err
+		}
+	}
+	return w, nil
+}
+
+// add records id in the state of the current block.
+func (w *Writer) add(id ulid.ULID) {
+	ms := id.Time()
+	if w.n == 0 || ms < w.idx.min {
+		w.idx.min = ms
+	}
+	if w.n == 0 || ms > w.idx.max {
+		w.idx.max = ms
+	}
+	w.crc.Write(id[:])
+	w.n++
+}
+
+func (w *Writer) writeIndex() error {
+	w.idx.crc = w.crc.Sum32()
+	if _, err := w.w.Write(w.idx.appendTo(make([]byte, 0, slotSize))); err != nil {
+		return err
+	}
+	w.n, w.idx = 0, index{}
+	w.crc.Reset()
+	return nil
+}
+
+// Append writes id at the end of the log.
+func (w *Writer) Append(id ulid.ULID) error {
+	if _, err := w.w.Write(id[:]); err != nil {
+		return err
+	}
+	w.add(id)
+	if w.n == BlockLen {
+		return w.writeIndex()
+	}
+	return nil
+}
+
+// Flush writes buffered ULIDs to the file.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// Sync flushes buffered ULIDs and commits the file to stable storage.
+func (w *Writer) Sync() error {
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return w.f.Sync()
+}
+
+// Close flushes buffered ULIDs and closes the file.
+func (w *Writer) Close() error {
+	err := w.Flush()
+	if cerr := w.f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// Reader reads a log file.
+//
+// A Reader is safe for concurrent use, each Iterator being used by a single
+// goroutine.
+type Reader struct {
+	r       io.ReaderAt
+	closer  io.Closer
+	n       int64
+	indexed int64 // blocks followed by their index record
+}
+
+// Open opens the log file name for reading.
+func Open(name string) (*Reader, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	st, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r, err := NewReader(f, st.Size())
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	r.closer = f
+	return r, nil
+}
+
+// NewReader returns a Reader reading a log of the given size from r.
+func NewReader(r io.ReaderAt, size int64) (*Reader, error) {
+	h := make([]byte, slotSize)
+	if _, err := r.ReadAt(h, 0); err != nil {
+		if err == io.EOF {
+			return nil, ErrFormat
+		}
+		return nil, err
+	}
+	if err := checkHeader(h); err != nil {
+		return nil, err
+	}
+	n, indexed := count(size)
+	return &Reader{r: r, n: n, indexed: indexed}, nil
+}
+
+// Len returns the number of ULIDs in the log.
+func (r *Reader) Len() int64 {
+	return r.n
+}
+
+// Close closes the file opened by Open. It does nothing for a Reader
+// created by NewReader.
+func (r *Reader) Close() error {
+	if r.closer == nil {
+		return nil
+	}
+	return r.closer.Close()
+}
+
+// Iter returns an Iterator over all the ULIDs of the log.
+func (r *Reader) Iter() *Iterator {
+	return &Iterator{r: r}
+}
+
+// Seek returns an Iterator positioned before the first ULID of the log
+// created at or after t. Only the index records and the matching block are
+// read, plus the trailing ULIDs not covered by an index record.
+func (r *Reader) Seek(t time.Time) (*Iterator, error) {
+	ms := t.UnixMilli()
+	if ms < 0 {
+		ms = 0
+	}
+
+	var err error
+	b := sort.Search(int(r.indexed), func(i int) bool {
+		if err != nil {
+			return true
+		}
+		buf := make([]byte, slotSize)
+		if _, err = r.r.ReadAt(buf, int64(i+1)*blockSize); err != nil {
+			return true
+		}
+		return parseIndex(buf).max >= uint64(ms)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Iterator{r: r, next: int64(b) * BlockLen, after: uint64(ms)}, nil
+}
+
+// Iterator iterates over the ULIDs of a log:
+//
+//	it := r.Iter()
+//	for it.Next() {
+//		id := it.ID()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator struct {
+	r     *Reader
+	next  int64  // position of the next ULID in the log
+	after uint64 // ULIDs older than this timestamp are skipped
+	buf   []byte // remaining ULIDs of the current block
+	id    ulid.ULID
+	err   error
+}
+
+// Next advances to the next ULID, returning false at the end of the log or
+// on error.
+func (it *Iterator) Next() bool {
+	for it.err == nil {
+		if len(it.buf) == 0 && !it.load() {
+			return false
+		}
+		it.id = ulid.ULID(it.buf[:slotSize])
+		it.buf = it.buf[slotSize:]
+		it.next++
+		if it.id.Time() >= it.after {
+			it.after = 0 // les ULIDs suivants sont plus récents
+			return true
+		}
+	}
+	return false
+}
+
+// load reads the block holding the next ULID.
+func (it *Iterator) load() bool {
+	if it.next >= it.r.n {
+		return false
+	}
+
+	first := it.next / BlockLen * BlockLen
+	full := first/BlockLen < it.r.indexed
+	n := min(it.r.n-first, BlockLen)
+	size := n * slotSize
+	if full {
+		size += slotSize
+	}
+
+	buf := make([]byte, size)
+	if _, err := it.r.r.ReadAt(buf, offset(first)); err != nil {
+		it.err = err
+		return false
+	}
+	if full {
+		data := buf[:BlockLen*slotSize]
+		if crc32.ChecksumIEEE(data) != parseIndex(buf[len(data):]).crc {
+			it.err = ErrCorrupt
+			return false
+		}
+		buf = data
+	}
+	it.buf = buf[(it.next-first)*slotSize:]
+	return true
+}
+
+// ID returns the current ULID.
+func (it *Iterator) ID() ulid.ULID {
+	return it.id
+}
+
+// Err returns the error met during the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,205 @@
+package ulidlog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+var base = time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+// write appends n ULIDs, one per millisecond from base+start ms.
+func write(t *testing.T, name string, start, n int) []ulid.ULID {
+	t.Helper()
+	w, err := Create(name)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	var ids []ulid.ULID
+	for i := start; i < start+n; i++ {
+		id := ulid.MakeWithTime(base.Add(time.Duration(i) * time.Millisecond))
+		if err := w.Append(id); err != nil {
+			t.Fatalf("Append() error = %v", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	return ids
+}
+
+func readAll(t *testing.T, it *Iterator) []ulid.ULID {
+	t.Helper()
+	var ids []ulid.ULID
+	for it.Next() {
+		ids = append(ids, it.ID())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Iterator error = %v", err)
+	}
+	return ids
+}
+
+func equal(a, b []ulid.ULID) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestAppendIterate(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "ids.log")
+
+	// Reopening in the middle of a block and at a block boundary
+	ids := write(t, name, 0, 300)
+	ids = append(ids, write(t, name, 300, 212)...)
+	ids = append(ids, write(t, name, 512, 10)...)
+
+	st, _ := os.Stat(name)
+	if want := int64(slotSize + 2*blockSize + 10*slotSize); st.Size() != want {
+		t.Errorf("file size = %d, want %d", st.Size(), want)
+	}
+
+	r, err := Open(name)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer r.Close()
+
+	if r.Len() != int64(len(ids)) {
+		t.Errorf("Len() = %d, want %d", r.Len(), len(ids))
+	}
+	if got := readAll(t, r.Iter()); !equal(got, ids) {
+		t.Errorf("Iter() returned %d ULIDs, want %d", len(got), len(ids))
+	}
+}
+
+func TestSeek(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "ids.log")
+	ids := write(t, name, 0, 1000)
+
+	r, err := Open(name)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer r.Close()
+
+	for _, i := range []int{0, 1, 255, 256, 700, 999} {
+		it, err := r.Seek(base.Add(time.Duration(i) * time.Millisecond))
+		if err != nil {
+			t.Fatalf("Seek() error = %v", err)
+		}
+		if got := readAll(t, it); !equal(got, ids[i:]) {
+			t.Errorf("Seek(%d) returned %d ULIDs, want %d", i, len(got), len(ids)-i)
+		}
+	}
+
+	it, _ := r.Seek(base.Add(time.Hour))
+	if it.Next() {
+		t.Errorf("Seek(after end) returned %v", it.ID())
+	}
+}
+
+func TestTornWrite(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "ids.log")
+	ids := write(t, name, 0, 5)
+
+	f, _ := os.OpenFile(name, os.O_APPEND|os.O_WRONLY, 0)
+	f.Write([]byte{1, 2, 3})
+	f.Close()
+
+	ids = append(ids, write(t, name, 5, 5)...)
+
+	r, err := Open(name)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer r.Close()
+	if got := readAll(t, r.Iter()); !equal(got, ids) {
+		t.Errorf("Iter() = %v, want %v", got, ids)
+	}
+}
+
+func TestMissingIndex(t *testing.T) {
+	// Crash after the last ULID of a block, before or while writing its
+	// index record
+	for _, cut := range []int64{slotSize, slotSize / 2} {
+		name := filepath.Join(t.TempDir(), "ids.log")
+		ids := write(t, name, 0, 2*BlockLen)
+		st, _ := os.Stat(name)
+		if err := os.Truncate(name, st.Size()-cut); err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := Open(name)
+		if err != nil {
+			t.Fatalf("Open() error = %v", err)
+		}
+		if r.Len() != int64(len(ids)) {
+			t.Errorf("cut %d: Len() = %d, want %d", cut, r.Len(), len(ids))
+		}
+		if got := readAll(t, r.Iter()); !equal(got, ids) {
+			t.Errorf("cut %d: Iter() returned %d ULIDs, want %d", cut, len(got), len(ids))
+		}
+		for _, i := range []int{0, 100, 300, 2*BlockLen - 1} {
+			it, err := r.Seek(base.Add(time.Duration(i) * time.Millisecond))
+			if err != nil {
+				t.Fatalf("cut %d: Seek(%d) error = %v", cut, i, err)
+			}
+			if got := readAll(t, it); !equal(got, ids[i:]) {
+				t.Errorf("cut %d: Seek(%d) returned %d ULIDs, want %d", cut, i, len(got), len(ids)-i)
+			}
+		}
+		r.Close()
+
+		// Le Writer réécrit l'index manquant
+		ids = append(ids, write(t, name, 2*BlockLen, 3)...)
+		r, err = Open(name)
+		if err != nil {
+			t.Fatalf("Open() error = %v", err)
+		}
+		if got := readAll(t, r.Iter()); !equal(got, ids) {
+			t.Errorf("cut %d: Iter() after reopening returned %d ULIDs, want %d", cut, len(got), len(ids))
+		}
+		r.Close()
+	}
+}
+
+func TestCorrupt(t *testing.T) {
+	name := filepath.Join(t.TempDir(), "ids.log")
+	write(t, name, 0, BlockLen)
+
+	f, _ := os.OpenFile(name, os.O_WRONLY, 0)
+	f.WriteAt([]byte{0xFF}, slotSize+20)
+	f.Close()
+
+	r, err := Open(name)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	defer r.Close()
+
+	it := r.Iter()
+	for it.Next() {
+	}
+	if it.Err() != ErrCorrupt {
+		t.Errorf("Err() = %v, want %v", it.Err(), ErrCorrupt)
+	}
+
+	os.WriteFile(name, []byte("not a ulid log!!"), 0o644)
+	if _, err := Open(name); err != ErrFormat {
+		t.Errorf("Open() error = %v, want %v", err, ErrFormat)
+	}
+	if _, err := Create(name); err != ErrFormat {
+		t.Errorf("Create() error = %v, want %v", err, ErrFormat)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/kamalshkeir/ulid/ulidpb
+
+go 1.25.4
+
+require (
+	github.com/kamalshkeir/ulid v1.0.0
+	google.golang.org/protobuf v1.36.12
+)
+
+replace github.com/kamalshkeir/ulid => ../
@@ -0,0 +1,126 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: ulid.proto
+
+package ulidpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ULID is a ULID in its 16 bytes binary form, which sorts like its
+// canonical 26 characters text form.
+type ULID struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The 16 bytes of the ULID, big-endian. Empty for the zero ULID.
+	Ulid          []byte `protobuf:"bytes,1,opt,name=ulid,proto3" json:"ulid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ULID) Reset() {
+	*x = ULID{}
+	mi := &file_ulid_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ULID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ULID) ProtoMessage() {}
+
+func (x *ULID) ProtoReflect() protoreflect.Message {
+	mi := &file_ulid_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ULID.ProtoReflect.Descriptor instead.
+func (*ULID) Descriptor() ([]byte, []int) {
+	return file_ulid_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ULID) GetUlid() []byte {
+	if x != nil {
+		return x.Ulid
+	}
+	return nil
+}
+
+var File_ulid_proto protoreflect.FileDescriptor
+
+const file_ulid_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"ulid.proto\x12\aulid.v1\"\x1a\n" +
+	"\x04ULID\x12\x12\n" +
+	"\x04ulid\x18\x01 \x01(\fR\x04ulidB$Z\"github.com/kamalshkeir/ulid/ulidpbb\x06proto3"
+
+var (
+	file_ulid_proto_rawDescOnce sync.Once
+	file_ulid_proto_rawDescData []byte
+)
+
+func file_ulid_proto_rawDescGZIP() []byte {
+	file_ulid_proto_rawDescOnce.Do(func() {
+		file_ulid_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_ulid_proto_rawDesc), len(file_ulid_proto_rawDesc)))
+	})
+	return file_ulid_proto_rawDescData
+}
+
+var file_ulid_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_ulid_proto_goTypes = []any{
+	(*ULID)(nil), // 0: ulid.v1.ULID
+}
+var file_ulid_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_ulid_proto_init() }
+func file_ulid_proto_init() {
+	if File_ulid_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_ulid_proto_rawDesc), len(file_ulid_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_ulid_proto_goTypes,
+		DependencyIndexes: file_ulid_proto_depIdxs,
+		MessageInfos:      file_ulid_proto_msgTypes,
+	}.Build()
+	File_ulid_proto = out.File
+	file_ulid_proto_goTypes = nil
+	file_ulid_proto_depIdxs = nil
+}
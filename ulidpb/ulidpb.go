@@ -0,0 +1,54 @@
+// Package ulidpb provides a protobuf message carrying ULIDs in binary form,
+// defined in ulid.proto, and converters from and to ulid.ULID.
+//
+// Import ulid.proto from other proto files to use the message as a field:
+//
+//	import "ulid.proto";
+//
+//	message Order {
+//	  ulid.v1.ULID id = 1;
+//	}
+package ulidpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative ulid.proto
+
+import (
+	"errors"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+// ErrSize is returned when the ulid field is neither empty nor 16 bytes long
+var ErrSize = errors.New("ulidpb: ulid field must be 16 bytes")
+
+// ToProto returns the message holding id. The zero ULID is encoded as an
+// empty field, so it is omitted from the wire.
+func ToProto(id ulid.ULID) *ULID {
+	if id.IsZero() {
+		return &ULID{}
+	}
+	return &ULID{Ulid: id.Bytes()}
+}
+
+// FromProto returns the ULID held by p. A nil message or an empty field
+// returns the zero ULID.
+func FromProto(p *ULID) (ulid.ULID, error) {
+	var id ulid.ULID
+	b := p.GetUlid()
+	switch len(b) {
+	case 0:
+		return id, nil
+	case ulid.RawSize:
+		copy(id[:], b)
+		return id, nil
+	default:
+		return id, ErrSize
+	}
+}
+
+// Validate returns an error if x does not hold a valid ULID. Use it to
+// check request messages before FromProto.
+func (x *ULID) Validate() error {
+	_, err := FromProto(x)
+	return err
+}
@@ -0,0 +1,48 @@
+package ulidpb
+
+import (
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRoundTrip(t *testing.T) {
+	for _, id := range []ulid.ULID{ulid.Make(), {}} {
+		b, err := proto.Marshal(ToProto(id))
+		if err != nil {
+			t.Fatalf("proto.Marshal() error = %v", err)
+		}
+
+		var p ULID
+		if err := proto.Unmarshal(b, &p); err != nil {
+			t.Fatalf("proto.Unmarshal() error = %v", err)
+		}
+		got, err := FromProto(&p)
+		if err != nil || got != id {
+			t.Errorf("FromProto() = %v, %v, want %v", got, err, id)
+		}
+	}
+
+	if b, _ := proto.Marshal(ToProto(ulid.ULID{})); len(b) != 0 {
+		t.Errorf("proto.Marshal(zero) = %x, want empty", b)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	var nilMsg *ULID
+	if err := nilMsg.Validate(); err != nil {
+		t.Errorf("Validate(nil) error = %v", err)
+	}
+	if id, err := FromProto(nil); err != nil || !id.IsZero() {
+		t.Errorf("FromProto(nil) = %v, %v", id, err)
+	}
+
+	bad := &ULID{Ulid: make([]byte, 10)}
+	if err := bad.Validate(); err != ErrSize {
+		t.Errorf("Validate() error = %v, want %v", err, ErrSize)
+	}
+	if _, err := FromProto(bad); err != ErrSize {
+		t.Errorf("FromProto() error = %v, want %v", err, ErrSize)
+	}
+}
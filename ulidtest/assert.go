@@ -0,0 +1,38 @@
+package ulidtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+// AssertSorted reports an error if ids are not in ascending order or
+// contain duplicates. It returns true if the assertion holds.
+func AssertSorted(tb testing.TB, ids []ulid.ULID) bool {
+	tb.Helper()
+	for i := 1; i < len(ids); i++ {
+		if ids[i-1].Compare(ids[i]) >= 0 {
+			tb.Errorf("ULIDs not sorted at index %d: %v >= %v", i, ids[i-1], ids[i])
+			return false
+		}
+	}
+	return true
+}
+
+// AssertWithin reports an error if the time of id is further than tolerance
+// from want. It returns true if the assertion holds.
+func AssertWithin(tb testing.TB, id ulid.ULID, want time.Time, tolerance time.Duration) bool {
+	tb.Helper()
+	got := ulid.Time(id.Time())
+	d := got.Sub(want)
+	if d < 0 {
+		d = -d
+	}
+	if d > tolerance {
+		tb.Errorf("ULID %v time %v is %v away from %v, tolerance %v",
+			id, got.Format(time.RFC3339Nano), d, want.Format(time.RFC3339Nano), tolerance)
+		return false
+	}
+	return true
+}
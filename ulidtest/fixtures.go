@@ -0,0 +1,82 @@
+package ulidtest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+// FrozenGenerator generates monotonic ULIDs at a fixed time, which only
+// changes when calling Set or Advance. It is safe for concurrent use.
+type FrozenGenerator struct {
+	mu  sync.Mutex
+	now time.Time
+	g   *ulid.Generator
+}
+
+// Frozen returns a FrozenGenerator with its clock stopped at t.
+func Frozen(t time.Time) *FrozenGenerator {
+	return &FrozenGenerator{now: t, g: ulid.NewGenerator(ulid.WithMonotonic())}
+}
+
+// Make returns a new ULID with the frozen time. It panics if the monotonic
+// entropy overflows, after about 2^79 ULIDs in the same millisecond.
+func (f *FrozenGenerator) Make() ulid.ULID {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id, err := f.g.NewWithTime(f.now)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// Now returns the frozen time.
+func (f *FrozenGenerator) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Set moves the clock to t.
+func (f *FrozenGenerator) Set(t time.Time) {
+	f.mu.Lock()
+	f.now = t
+	f.mu.Unlock()
+}
+
+// Advance moves the clock forward by d.
+func (f *FrozenGenerator) Advance(d time.Duration) {
+	f.mu.Lock()
+	f.now = f.now.Add(d)
+	f.mu.Unlock()
+}
+
+// Sequence generates deterministic ULIDs: start, start+1, start+2... It is
+// safe for concurrent use.
+type Sequence struct {
+	mu   sync.Mutex
+	next ulid.ULID
+}
+
+// Sequential returns a Sequence starting at start.
+func Sequential(start ulid.ULID) *Sequence {
+	return &Sequence{next: start}
+}
+
+// Make returns the next ULID of the sequence. It panics when the sequence
+// goes past the greatest ULID.
+func (s *Sequence) Make() ulid.ULID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.next
+	next, ok := id.Increment()
+	if !ok {
+		panic("ulidtest: sequence overflow")
+	}
+	s.next = next
+	return id
+}
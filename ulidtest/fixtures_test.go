@@ -0,0 +1,83 @@
+package ulidtest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestFrozen(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	f := Frozen(now)
+
+	ids := []ulid.ULID{f.Make(), f.Make(), f.Make()}
+	AssertSorted(t, ids)
+	for _, id := range ids {
+		if id.Time() != ulid.Timestamp(now) {
+			t.Errorf("Make().Time() = %d, want %d", id.Time(), ulid.Timestamp(now))
+		}
+	}
+
+	f.Advance(time.Second)
+	if got := f.Make(); got.Time() != ulid.Timestamp(now.Add(time.Second)) {
+		t.Errorf("Make() after Advance() time = %v", ulid.Time(got.Time()))
+	}
+	f.Set(now)
+	if !f.Now().Equal(now) {
+		t.Errorf("Now() = %v, want %v", f.Now(), now)
+	}
+}
+
+func TestSequential(t *testing.T) {
+	start := ulid.MustNew(1000, nil)
+	s := Sequential(start)
+
+	if got := s.Make(); got != start {
+		t.Errorf("Make() = %v, want %v", got, start)
+	}
+	next, _ := start.Increment()
+	if got := s.Make(); got != next {
+		t.Errorf("Make() = %v, want %v", got, next)
+	}
+
+	// Deterministic
+	if a, b := Sequential(start).Make(), Sequential(start).Make(); a != b {
+		t.Errorf("Sequential() not deterministic: %v != %v", a, b)
+	}
+}
+
+func TestAssertions(t *testing.T) {
+	s := Sequential(ulid.MustNew(1000, nil))
+	a, b := s.Make(), s.Make()
+
+	if !AssertSorted(t, []ulid.ULID{a, b}) {
+		t.Error("AssertSorted() = false for sorted ULIDs")
+	}
+	if !AssertWithin(t, a, ulid.Time(1005), 5*time.Millisecond) {
+		t.Error("AssertWithin() = false within tolerance")
+	}
+
+	rec := &recorder{TB: t}
+	if AssertSorted(rec, []ulid.ULID{b, a}) || AssertSorted(rec, []ulid.ULID{a, a}) {
+		t.Error("AssertSorted() = true for unsorted ULIDs")
+	}
+	if AssertWithin(rec, a, ulid.Time(900), 50*time.Millisecond) {
+		t.Error("AssertWithin() = true out of tolerance")
+	}
+	if rec.errors != 3 {
+		t.Errorf("assertions reported %d errors, want 3", rec.errors)
+	}
+}
+
+// recorder counts errors instead of failing the test.
+type recorder struct {
+	testing.TB
+	errors int
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors++
+}
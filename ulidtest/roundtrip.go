@@ -0,0 +1,68 @@
+package ulidtest
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+// RoundTripText checks that data, if it parses as a ULID, encodes back to
+// the same text and parses to the same ULID. Invalid inputs are ignored, so
+// it can be called from fuzz targets with arbitrary data:
+//
+//	func FuzzULID(f *testing.F) {
+//		f.Fuzz(func(t *testing.T, data []byte) {
+//			if err := ulidtest.RoundTripText(data); err != nil {
+//				t.Fatal(err)
+//			}
+//		})
+//	}
+func RoundTripText(data []byte) error {
+	loose, looseErr := ulid.Parse(string(data))
+	id, err := ulid.ParseStrict(string(data))
+	if err != nil {
+		return nil
+	}
+	if looseErr != nil || loose != id {
+		return fmt.Errorf("ulidtest: Parse(%q) = %v, %v, ParseStrict = %v", data, loose, looseErr, id)
+	}
+
+	s := id.String()
+	if s != string(bytes.ToUpper(data)) {
+		return fmt.Errorf("ulidtest: ParseStrict(%q).String() = %q", data, s)
+	}
+	return checkText(id)
+}
+
+// RoundTripBinary checks that data, if it is a 16 bytes binary ULID,
+// marshals back to the same bytes and round-trips through the text form.
+// Invalid inputs are ignored, so it can be called from fuzz targets.
+func RoundTripBinary(data []byte) error {
+	var id ulid.ULID
+	if err := id.UnmarshalBinary(data); err != nil {
+		if len(data) == ulid.RawSize {
+			return fmt.Errorf("ulidtest: UnmarshalBinary(%x) error = %v", data, err)
+		}
+		return nil
+	}
+
+	b, err := id.MarshalBinary()
+	if err != nil || !bytes.Equal(b, data) {
+		return fmt.Errorf("ulidtest: MarshalBinary() = %x, %v, want %x", b, err, data)
+	}
+	return checkText(id)
+}
+
+// checkText checks the text round-trip of id.
+func checkText(id ulid.ULID) error {
+	text, err := id.MarshalText()
+	if err != nil {
+		return fmt.Errorf("ulidtest: %x MarshalText() error = %v", id[:], err)
+	}
+	var got ulid.ULID
+	if err := got.UnmarshalText(text); err != nil || got != id {
+		return fmt.Errorf("ulidtest: UnmarshalText(%q) = %x, %v, want %x", text, got[:], err, id[:])
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package ulidtest
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func FuzzRoundTripText(f *testing.F) {
+	f.Add([]byte(ulid.Make().String()))
+	f.Add([]byte("01an4z07by79ka1307sr9x4mv3"))
+	f.Add([]byte("7ZZZZZZZZZZZZZZZZZZZZZZZZZ"))
+	f.Add([]byte("8ZZZZZZZZZZZZZZZZZZZZZZZZZ"))
+	f.Add([]byte("01AN4Z07BY79KA1307SR9X4MVU"))
+	f.Add([]byte(""))
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := RoundTripText(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func FuzzRoundTripBinary(f *testing.F) {
+	id := ulid.Make()
+	f.Add(id[:])
+	f.Add(make([]byte, ulid.RawSize))
+	f.Add(bytes.Repeat([]byte{0xFF}, ulid.RawSize))
+	f.Add([]byte{1, 2, 3})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := RoundTripBinary(data); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestRoundTripInvalid(t *testing.T) {
+	for _, s := range []string{"", "nope", "8ZZZZZZZZZZZZZZZZZZZZZZZZZ"} {
+		if err := RoundTripText([]byte(s)); err != nil {
+			t.Errorf("RoundTripText(%q) error = %v, want nil", s, err)
+		}
+	}
+	if err := RoundTripBinary([]byte{1}); err != nil {
+		t.Errorf("RoundTripBinary(short) error = %v, want nil", err)
+	}
+}
@@ -0,0 +1,94 @@
+// Package ulidtest provides helpers to test code using ULIDs: generators
+// for property-based tests with testing/quick, deterministic fixtures,
+// assertions and round-trip checkers for fuzz targets.
+package ulidtest
+
+import (
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+// Pattern selects how the entropy of generated ULIDs is filled.
+type Pattern int
+
+const (
+	// Random fills the entropy with random bytes
+	Random Pattern = iota
+
+	// Zero leaves the entropy at zero, the first ULID of a millisecond
+	Zero
+
+	// Max sets all the entropy bits, so the next monotonic ULID overflows
+	Max
+
+	// NearMax sets all the entropy bits but a random low byte, to test
+	// monotonic overflows after a few increments
+	NearMax
+
+	// Mixed picks one of the other patterns for each ULID
+	Mixed
+)
+
+// Gen generates ULIDs whose timestamp is in the [From, To] range and whose
+// entropy follows the Entropy pattern. The zero value generates ULIDs over
+// the whole timestamp range with random entropy.
+//
+//	g := ulidtest.Gen{From: start, To: end, Entropy: ulidtest.Mixed}
+//	err := quick.Check(prop, &quick.Config{Values: g.Values})
+type Gen struct {
+	From, To time.Time
+	Entropy  Pattern
+}
+
+// ULID returns a ULID generated from r.
+func (g Gen) ULID(r *rand.Rand) ulid.ULID {
+	lo, hi := uint64(0), uint64(ulid.MaxTime)
+	if !g.From.IsZero() {
+		lo = ulid.Timestamp(g.From)
+	}
+	if !g.To.IsZero() {
+		hi = ulid.Timestamp(g.To)
+	}
+
+	var id ulid.ULID
+	ms := lo
+	if hi > lo {
+		ms += uint64(r.Int63n(int64(hi - lo + 1)))
+	}
+	_ = id.SetTime(ms)
+
+	p := g.Entropy
+	if p == Mixed {
+		p = Pattern(r.Intn(int(Mixed)))
+	}
+	e := id[6:]
+	switch p {
+	case Random:
+		r.Read(e)
+	case Max, NearMax:
+		for i := range e {
+			e[i] = 0xFF
+		}
+		if p == NearMax {
+			e[len(e)-1] = byte(r.Intn(256))
+		}
+	}
+	return id
+}
+
+// Generate returns a reflect.Value holding a ULID generated from r, so a Gen
+// can be used as a testing/quick.Generator.
+func (g Gen) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(g.ULID(r))
+}
+
+// Values fills args with ULIDs generated from r. It can be used as
+// quick.Config.Values for properties whose arguments are all ULIDs.
+func (g Gen) Values(args []reflect.Value, r *rand.Rand) {
+	for i := range args {
+		args[i] = g.Generate(r, 0)
+	}
+}
@@ -0,0 +1,56 @@
+package ulidtest
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/kamalshkeir/ulid"
+)
+
+func TestGen(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	g := Gen{From: from, To: to}
+
+	inRange := func(id ulid.ULID) bool {
+		ts := ulid.Time(id.Time())
+		return !ts.Before(from) && !ts.After(to)
+	}
+	if err := quick.Check(inRange, &quick.Config{Values: g.Values}); err != nil {
+		t.Error(err)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	if id := (Gen{Entropy: Zero}).ULID(r); !bytes.Equal(id.Entropy(), make([]byte, 10)) {
+		t.Errorf("Zero entropy = %x", id.Entropy())
+	}
+	if id := (Gen{Entropy: Max}).ULID(r); !bytes.Equal(id.Entropy(), bytes.Repeat([]byte{0xFF}, 10)) {
+		t.Errorf("Max entropy = %x", id.Entropy())
+	}
+	if id := (Gen{Entropy: NearMax}).ULID(r); !bytes.Equal(id.Entropy()[:9], bytes.Repeat([]byte{0xFF}, 9)) {
+		t.Errorf("NearMax entropy = %x", id.Entropy())
+	}
+
+	// Timestamp fixed by an empty range
+	if id := (Gen{From: from, To: from}).ULID(r); id.Time() != ulid.Timestamp(from) {
+		t.Errorf("ULID().Time() = %d, want %d", id.Time(), ulid.Timestamp(from))
+	}
+}
+
+func TestGenValues(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	g := Gen{From: from, To: from.Add(time.Millisecond), Entropy: Mixed}
+
+	args := make([]reflect.Value, 2)
+	g.Values(args, rand.New(rand.NewSource(1)))
+	for i, v := range args {
+		id, ok := v.Interface().(ulid.ULID)
+		if !ok || id.Time()-ulid.Timestamp(from) > 1 {
+			t.Errorf("Values()[%d] = %v", i, v)
+		}
+	}
+}
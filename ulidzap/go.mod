@@ -0,0 +1,12 @@
+module github.com/kamalshkeir/ulid/ulidzap
+
+go 1.25.4
+
+require (
+	github.com/kamalshkeir/ulid v1.0.0
+	go.uber.org/zap v1.28.0
+)
+
+require go.uber.org/multierr v1.10.0 // indirect
+
+replace github.com/kamalshkeir/ulid => ../
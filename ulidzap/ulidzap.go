@@ -0,0 +1,33 @@
+// Package ulidzap provides zap fields for ULIDs.
+//
+//	logger.Info("request", ulidzap.ID("request_id", id))
+//
+// The fields encode the ULID directly instead of going through
+// fmt.Stringer: ULID.String interns every ID it formats, which is wasteful
+// for request IDs that are logged once and never seen again.
+package ulidzap
+
+import (
+	"github.com/kamalshkeir/ulid"
+	"go.uber.org/zap"
+)
+
+// ID returns a field holding the canonical text form of id. It costs a
+// single 26 bytes allocation.
+func ID(key string, id ulid.ULID) zap.Field {
+	a := id.StringArray()
+	return zap.String(key, string(a[:]))
+}
+
+// Binary returns a field holding the 16 bytes binary form of id, for
+// binary encoders. Text encoders write it in base64.
+func Binary(key string, id ulid.ULID) zap.Field {
+	b := make([]byte, ulid.RawSize)
+	copy(b, id[:])
+	return zap.Binary(key, b)
+}
+
+// IDs returns a field holding the text form of ids as an array.
+func IDs(key string, ids []ulid.ULID) zap.Field {
+	return zap.Strings(key, ulid.EncodeAll(ids))
+}
@@ -0,0 +1,42 @@
+package ulidzap
+
+import (
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestFields(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+	logger := zap.New(core)
+	a, b := ulid.Make(), ulid.Make()
+
+	logger.Info("request", ID("id", a), Binary("raw", a), IDs("ids", []ulid.ULID{a, b}))
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d entries, want 1", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if got := fields["id"]; got != a.String() {
+		t.Errorf("ID() = %v, want %s", got, a)
+	}
+	if got, _ := fields["raw"].([]byte); string(got) != string(a[:]) {
+		t.Errorf("Binary() = %x, want %x", got, a[:])
+	}
+	if got, _ := fields["ids"].([]interface{}); len(got) != 2 || got[0] != a.String() || got[1] != b.String() {
+		t.Errorf("IDs() = %v, want [%s %s]", fields["ids"], a, b)
+	}
+}
+
+var sink zap.Field
+
+func TestIDAllocs(t *testing.T) {
+	id := ulid.Make()
+	if n := testing.AllocsPerRun(100, func() { sink = ID("id", id) }); n != 1 {
+		t.Errorf("ID() allocs = %v, want 1", n)
+	}
+}
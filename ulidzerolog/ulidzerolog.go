@@ -0,0 +1,54 @@
+// Package ulidzerolog adds ULIDs to zerolog events without allocating.
+//
+//	ulidzerolog.ID(log.Info(), "user_id", id).Msg("login")
+//
+// Hook adds the request ID stored by ulidhttp to every event logged with
+// a context:
+//
+//	logger := zerolog.New(os.Stdout).Hook(ulidzerolog.Hook{})
+//	logger.Info().Ctx(r.Context()).Msg("request")
+package ulidzerolog
+
+import (
+	"github.com/kamalshkeir/ulid"
+	"github.com/kamalshkeir/ulid/ulidhttp"
+	"github.com/rs/zerolog"
+)
+
+// DefaultKey is the field name used by Hook when Key is empty.
+const DefaultKey = "request_id"
+
+// ID adds the canonical text form of id to e under key and returns e. The
+// text is encoded on the stack and copied to the event buffer, without the
+// allocation of ULID.String.
+func ID(e *zerolog.Event, key string, id ulid.ULID) *zerolog.Event {
+	a := id.StringArray()
+	return e.Bytes(key, a[:])
+}
+
+// Binary adds the 16 bytes binary form of id to e under key and returns e.
+// The JSON encoder writes it as a hex string.
+func Binary(e *zerolog.Event, key string, id ulid.ULID) *zerolog.Event {
+	return e.Hex(key, id[:])
+}
+
+// Hook is a zerolog.Hook adding the request ID found in the context of the
+// event, see ulidhttp.FromContext. Events without context or request ID are
+// left untouched.
+type Hook struct {
+	// Key is the field name, DefaultKey if empty
+	Key string
+}
+
+// Run implements the zerolog.Hook interface.
+func (h Hook) Run(e *zerolog.Event, _ zerolog.Level, _ string) {
+	id, ok := ulidhttp.FromContext(e.GetCtx())
+	if !ok {
+		return
+	}
+	key := h.Key
+	if key == "" {
+		key = DefaultKey
+	}
+	ID(e, key, id)
+}
@@ -0,0 +1,70 @@
+package ulidzerolog
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/kamalshkeir/ulid"
+	"github.com/kamalshkeir/ulid/ulidhttp"
+	"github.com/rs/zerolog"
+)
+
+func decodeLine(t *testing.T, buf *bytes.Buffer) map[string]interface{} {
+	t.Helper()
+	var m map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &m); err != nil {
+		t.Fatalf("invalid log line %q: %v", buf.Bytes(), err)
+	}
+	buf.Reset()
+	return m
+}
+
+func TestID(t *testing.T) {
+	var buf bytes.Buffer
+	logger := zerolog.New(&buf)
+	id := ulid.Make()
+
+	Binary(ID(logger.Info(), "id", id), "raw", id).Msg("")
+	m := decodeLine(t, &buf)
+	if m["id"] != id.String() {
+		t.Errorf("ID() = %v, want %s", m["id"], id)
+	}
+	if m["raw"] != hex.EncodeToString(id[:]) {
+		t.Errorf("Binary() = %v, want %x", m["raw"], id[:])
+	}
+}
+
+func TestHook(t *testing.T) {
+	var buf bytes.Buffer
+	id := ulid.Make()
+	ctx := ulidhttp.NewContext(context.Background(), id)
+
+	logger := zerolog.New(&buf).Hook(Hook{})
+	logger.Info().Ctx(ctx).Msg("")
+	if m := decodeLine(t, &buf); m[DefaultKey] != id.String() {
+		t.Errorf("Hook{} added %v, want %s", m[DefaultKey], id)
+	}
+
+	logger.Info().Msg("")
+	if m := decodeLine(t, &buf); m[DefaultKey] != nil {
+		t.Errorf("Hook{} without context added %v", m[DefaultKey])
+	}
+
+	logger = zerolog.New(&buf).Hook(Hook{Key: "rid"})
+	logger.Info().Ctx(ctx).Msg("")
+	if m := decodeLine(t, &buf); m["rid"] != id.String() {
+		t.Errorf("Hook{Key: rid} added %v, want %s", m["rid"], id)
+	}
+}
+
+func TestIDAllocs(t *testing.T) {
+	logger := zerolog.New(io.Discard)
+	id := ulid.Make()
+	if n := testing.AllocsPerRun(100, func() { ID(logger.Info(), "id", id).Send() }); n != 0 {
+		t.Errorf("ID() allocs = %v, want 0", n)
+	}
+}
@@ -0,0 +1,31 @@
+package ulid
+
+import (
+	"errors"
+	"strings"
+)
+
+// URNPrefix is the prefix of the URN form of ULIDs.
+const URNPrefix = "urn:ulid:"
+
+// ErrURN is returned by ParseURN when the input does not start with
+// URNPrefix.
+var ErrURN = errors.New("ulid: invalid URN")
+
+// URN returns the ULID in the URN form urn:ulid:<id>.
+func (id ULID) URN() string {
+	var buf [len(URNPrefix) + EncodedSize]byte
+	copy(buf[:], URNPrefix)
+	_ = id.MarshalTextTo(buf[len(URNPrefix):])
+	return string(buf[:])
+}
+
+// ParseURN parses a ULID in the URN form returned by URN. As per RFC 8141,
+// the "urn:ulid:" prefix is case insensitive. The ULID itself is parsed
+// with ParseStrict.
+func ParseURN(s string) (ULID, error) {
+	if len(s) < len(URNPrefix) || !strings.EqualFold(s[:len(URNPrefix)], URNPrefix) {
+		return ULID{}, ErrURN
+	}
+	return ParseStrict(s[len(URNPrefix):])
+}
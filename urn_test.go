@@ -0,0 +1,34 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestURN(t *testing.T) {
+	id := MustParse("01AN4Z07BY79KA1307SR9X4MV3")
+	if got := id.URN(); got != "urn:ulid:01AN4Z07BY79KA1307SR9X4MV3" {
+		t.Errorf("URN() = %s, want urn:ulid:01AN4Z07BY79KA1307SR9X4MV3", got)
+	}
+
+	tests := []struct {
+		s   string
+		err error
+	}{
+		{"urn:ulid:01AN4Z07BY79KA1307SR9X4MV3", nil},
+		{"URN:ULID:01AN4Z07BY79KA1307SR9X4MV3", nil},
+		{"urn:ulid:01an4z07by79ka1307sr9x4mv3", nil},
+		{"01AN4Z07BY79KA1307SR9X4MV3", ErrURN},
+		{"urn:uuid:01AN4Z07BY79KA1307SR9X4MV3", ErrURN},
+		{"urn:ulid:", ErrDataSize},
+		{"urn:ulid:01AN4Z07BY79KA1307SR9X4M!3", ErrInvalidCharacters},
+	}
+	for _, tt := range tests {
+		got, err := ParseURN(tt.s)
+		if err != tt.err {
+			t.Errorf("ParseURN(%s) error = %v, want %v", tt.s, err, tt.err)
+		}
+		if err == nil && got != id {
+			t.Errorf("ParseURN(%s) = %v, want %v", tt.s, got, id)
+		}
+	}
+}
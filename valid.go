@@ -0,0 +1,25 @@
+package ulid
+
+// Valid returns true if s is a valid encoded ULID, as accepted by
+// ParseStrict. It does not allocate.
+func Valid(s string) bool {
+	return valid(s)
+}
+
+// ValidBytes returns true if b is a valid encoded ULID, as accepted by
+// ParseStrict. It does not allocate.
+func ValidBytes(b []byte) bool {
+	return valid(b)
+}
+
+func valid[T string | []byte](v T) bool {
+	if len(v) != EncodedSize || v[0] > '7' {
+		return false
+	}
+	for i := 0; i < EncodedSize; i++ {
+		if dec[v[i]] == 0xFF {
+			return false
+		}
+	}
+	return true
+}
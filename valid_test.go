@@ -0,0 +1,45 @@
+package ulid
+
+import (
+	"testing"
+)
+
+func TestValid(t *testing.T) {
+	tests := []struct {
+		s    string
+		want bool
+	}{
+		{Make().String(), true},
+		{"01an4z07by79ka1307sr9x4mv3", true},
+		{"7ZZZZZZZZZZZZZZZZZZZZZZZZZ", true},
+		{"8ZZZZZZZZZZZZZZZZZZZZZZZZZ", false},
+		{"01AN4Z07BY79KA1307SR9X4MVU", false},
+		{"01AN4Z07BY79KA1307SR9X4MV", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := Valid(tt.s); got != tt.want {
+			t.Errorf("Valid(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+		if got := ValidBytes([]byte(tt.s)); got != tt.want {
+			t.Errorf("ValidBytes(%q) = %v, want %v", tt.s, got, tt.want)
+		}
+		_, err := ParseStrict(tt.s)
+		if (err == nil) != tt.want {
+			t.Errorf("Valid(%q) = %v, ParseStrict() error = %v", tt.s, tt.want, err)
+		}
+	}
+
+	s := Make().String()
+	if n := testing.AllocsPerRun(100, func() { Valid(s) }); n != 0 {
+		t.Errorf("Valid() allocs = %v, want 0", n)
+	}
+}
+
+func BenchmarkValid(b *testing.B) {
+	s := Make().String()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		Valid(s)
+	}
+}
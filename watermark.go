@@ -0,0 +1,53 @@
+package ulid
+
+import "time"
+
+// Watermark tracks the event time of a stream of ULIDs: the greatest
+// timestamp seen minus an allowed lateness. Events older than the
+// watermark are late, e.g. too late to be counted in a window that was
+// already emitted.
+//
+// A Watermark is NOT safe for concurrent use.
+type Watermark struct {
+	lateness uint64
+	max      uint64
+	seen     bool
+}
+
+// NewWatermark returns a Watermark allowing events to arrive up to
+// lateness after newer ones. A negative lateness is treated as 0.
+func NewWatermark(lateness time.Duration) *Watermark {
+	return &Watermark{lateness: uint64(max(lateness.Milliseconds(), 0))}
+}
+
+// Advance records id and returns true if the watermark moved forward.
+// Late and out of order IDs never move the watermark back.
+func (w *Watermark) Advance(id ULID) bool {
+	ms := id.Time()
+	if w.seen && ms <= w.max {
+		return false
+	}
+	w.max, w.seen = ms, true
+	return true
+}
+
+// Current returns the watermark, or the zero time.Time if no ULID was
+// recorded yet.
+func (w *Watermark) Current() time.Time {
+	if !w.seen {
+		return time.Time{}
+	}
+	return Time(w.current())
+}
+
+// IsLate returns true if id is older than the watermark.
+func (w *Watermark) IsLate(id ULID) bool {
+	return w.seen && id.Time() < w.current()
+}
+
+func (w *Watermark) current() uint64 {
+	if w.max < w.lateness {
+		return 0
+	}
+	return w.max - w.lateness
+}
@@ -0,0 +1,50 @@
+package ulid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWatermark(t *testing.T) {
+	w := NewWatermark(5 * time.Millisecond)
+	if !w.Current().IsZero() {
+		t.Errorf("Current() = %v, want zero", w.Current())
+	}
+	if w.IsLate(MustNew(0, nil)) {
+		t.Error("IsLate() should return false before any Advance()")
+	}
+
+	if !w.Advance(MustNew(100, nil)) {
+		t.Error("Advance(100) should move the watermark")
+	}
+	if got := w.Current(); !got.Equal(Time(95)) {
+		t.Errorf("Current() = %v, want %v", got, Time(95))
+	}
+	if w.Advance(MustNew(98, nil)) || w.Advance(MustNew(100, nil)) {
+		t.Error("Advance() should not move the watermark back or in place")
+	}
+	if got := w.Current(); !got.Equal(Time(95)) {
+		t.Errorf("Current() = %v, want %v", got, Time(95))
+	}
+
+	tests := []struct {
+		ms   uint64
+		late bool
+	}{
+		{94, true},
+		{95, false},
+		{120, false},
+	}
+	for _, tt := range tests {
+		if got := w.IsLate(MustNew(tt.ms, nil)); got != tt.late {
+			t.Errorf("IsLate(%d) = %v, want %v", tt.ms, got, tt.late)
+		}
+	}
+
+	// The watermark never goes before the epoch
+	w = NewWatermark(time.Hour)
+	w.Advance(MustNew(10, nil))
+	if got := w.Current(); !got.Equal(Time(0)) {
+		t.Errorf("Current() = %v, want %v", got, Time(0))
+	}
+}
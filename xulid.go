@@ -0,0 +1,177 @@
+package ulid
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+)
+
+const (
+	// XEncodedSize is the length of a text encoded XULID
+	XEncodedSize = 32
+
+	// XRawSize is the length of a binary encoded XULID
+	XRawSize = 20
+)
+
+// maxXMillis is the last Unix time in milliseconds whose nanoseconds fit the
+// 64 bit XULID timestamp.
+const maxXMillis = math.MaxUint64 / 1_000_000
+
+// XULID is a 20 byte extended ULID made of a 64-bit Unix timestamp in
+// nanoseconds followed by 96 bits of entropy. It keeps the lexicographic
+// sortability of ULIDs while ordering IDs created within the same
+// millisecond.
+//
+// XULIDs are encoded as 32 characters of Crockford's Base32.
+type XULID [XRawSize]byte
+
+// NewX returns an XULID with the given Unix nanoseconds timestamp and an
+// optional entropy source, defaulting to crypto/rand or the source set
+// with SetEntropySource.
+func NewX(ns uint64, entropy io.Reader) (XULID, error) {
+	var x XULID
+	binary.BigEndian.PutUint64(x[:8], ns)
+
+	var err error
+	if entropy == nil {
+		_, err = readRandom(x[8:])
+	} else {
+		_, err = io.ReadFull(entropy, x[8:])
+	}
+	if err != nil {
+		return XULID{}, err
+	}
+	return x, nil
+}
+
+// MakeX returns an XULID with the current time and entropy from
+// crypto/rand.Reader, or from the source set with SetEntropySource.
+func MakeX() XULID {
+	var x XULID
+	binary.BigEndian.PutUint64(x[:8], uint64(time.Now().UnixNano()))
+	_, _ = readRandom(x[8:])
+	return x
+}
+
+// ParseX parses an encoded XULID. ErrDataSize is returned if len(s) is
+// different from XEncodedSize and ErrInvalidCharacters if s contains
+// invalid base32 characters.
+func ParseX(s string) (XULID, error) {
+	var x XULID
+	return x, x.UnmarshalText([]byte(s))
+}
+
+// Time returns the Unix time in nanoseconds encoded in the XULID.
+func (x XULID) Time() uint64 {
+	return binary.BigEndian.Uint64(x[:8])
+}
+
+// Timestamp returns the time encoded in the XULID.
+func (x XULID) Timestamp() time.Time {
+	return time.Unix(0, int64(x.Time()))
+}
+
+// Entropy returns the 12 entropy bytes of the XULID.
+func (x XULID) Entropy() []byte {
+	e := make([]byte, 12)
+	copy(e, x[8:])
+	return e
+}
+
+// Compare returns an integer comparing x and other lexicographically.
+// The result will be 0 if x==other, -1 if x < other, and +1 if x > other.
+func (x XULID) Compare(other XULID) int {
+	for i := 0; i < XRawSize; i++ {
+		if x[i] < other[i] {
+			return -1
+		}
+		if x[i] > other[i] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// ULID converts x to a standard ULID, truncating the timestamp to
+// milliseconds and keeping the first 10 entropy bytes.
+func (x XULID) ULID() ULID {
+	var id ULID
+	_ = id.SetTime(x.Time() / 1e6)
+	copy(id[6:], x[8:])
+	return id
+}
+
+// XULID converts id to an XULID. The timestamp is expanded to nanoseconds
+// and the two missing entropy bytes are zero. Nanoseconds only fit 64 bits
+// up to the year 2554: later timestamps are clamped to the last millisecond
+// that fits, so the XULID keeps sorting after any earlier one.
+func (id ULID) XULID() XULID {
+	var x XULID
+	binary.BigEndian.PutUint64(x[:8], min(id.Time(), maxXMillis)*1e6)
+	copy(x[8:], id[6:])
+	return x
+}
+
+// String returns the 32 character encoding of the XULID.
+func (x XULID) String() string {
+	var buf [XEncodedSize]byte
+	_ = x.MarshalTextTo(buf[:])
+	return string(buf[:])
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (x XULID) MarshalText() ([]byte, error) {
+	buf := make([]byte, XEncodedSize)
+	return buf, x.MarshalTextTo(buf)
+}
+
+// MarshalTextTo writes the XULID as a string to the given buffer.
+// ErrBufferSize is returned when len(dst) != XEncodedSize.
+func (x XULID) MarshalTextTo(dst []byte) error {
+	if len(dst) != XEncodedSize {
+		return ErrBufferSize
+	}
+
+	// Chaque groupe de 5 octets (40 bits) donne exactement 8 caractères
+	for i := 0; i < 4; i++ {
+		src := x[i*5 : i*5+5]
+		v := uint64(src[0])<<32 | uint64(src[1])<<24 | uint64(src[2])<<16 |
+			uint64(src[3])<<8 | uint64(src[4])
+		out := dst[i*8 : i*8+8]
+		for j := 7; j >= 0; j-- {
+			out[j] = enc[v&31]
+			v >>= 5
+		}
+	}
+	return nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (x *XULID) UnmarshalText(v []byte) error {
+	if len(v) != XEncodedSize {
+		return ErrDataSize
+	}
+
+	var res XULID
+	for i := 0; i < 4; i++ {
+		var n uint64
+		for _, c := range v[i*8 : i*8+8] {
+			d := dec[c]
+			if d == 0xFF {
+				return ErrInvalidCharacters
+			}
+			n = n<<5 | uint64(d)
+		}
+		dst := res[i*5 : i*5+5]
+		dst[0] = byte(n >> 32)
+		dst[1] = byte(n >> 24)
+		dst[2] = byte(n >> 16)
+		dst[3] = byte(n >> 8)
+		dst[4] = byte(n)
+	}
+
+	*x = res
+	return nil
+}
@@ -0,0 +1,72 @@
+package ulid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestXULID(t *testing.T) {
+	x := MakeX()
+	s := x.String()
+	if len(s) != XEncodedSize {
+		t.Errorf("String() length = %v, want %v", len(s), XEncodedSize)
+	}
+
+	parsed, err := ParseX(s)
+	if err != nil {
+		t.Fatalf("ParseX() error = %v", err)
+	}
+	if parsed != x {
+		t.Errorf("ParseX(String()) = %v, want %v", parsed, x)
+	}
+
+	if _, err := ParseX(s[1:]); err != ErrDataSize {
+		t.Errorf("ParseX(short) error = %v, want %v", err, ErrDataSize)
+	}
+	if _, err := ParseX("!" + s[1:]); err != ErrInvalidCharacters {
+		t.Errorf("ParseX(invalid) error = %v, want %v", err, ErrInvalidCharacters)
+	}
+}
+
+func TestXULIDOrdering(t *testing.T) {
+	ns := uint64(time.Now().UnixNano())
+	a, _ := NewX(ns, nil)
+	b, _ := NewX(ns+1, nil)
+
+	if a.Compare(b) >= 0 {
+		t.Error("Compare() should order XULIDs by nanosecond timestamp")
+	}
+	if a.String() >= b.String() {
+		t.Error("String() encoding should preserve ordering")
+	}
+	if a.Time() != ns {
+		t.Errorf("Time() = %v, want %v", a.Time(), ns)
+	}
+}
+
+func TestXULIDConversion(t *testing.T) {
+	id := Make()
+	x := id.XULID()
+	if x.Time() != id.Time()*1e6 {
+		t.Errorf("XULID().Time() = %v, want %v", x.Time(), id.Time()*1e6)
+	}
+	if got := x.ULID(); got != id {
+		t.Errorf("XULID().ULID() = %v, want %v", got, id)
+	}
+}
+
+func TestXULIDConversionClamp(t *testing.T) {
+	last := MustNew(maxXMillis, nil).XULID()
+	if want := uint64(maxXMillis * 1e6); last.Time() != want {
+		t.Errorf("XULID().Time() = %v, want %v", last.Time(), want)
+	}
+	for _, ms := range []uint64{maxXMillis + 1, MaxTime} {
+		x := MustNew(ms, nil).XULID()
+		if x.Time() != last.Time() {
+			t.Errorf("XULID(%d).Time() = %v, want %v", ms, x.Time(), last.Time())
+		}
+		if x.Time() < MustNew(maxXMillis-1, nil).XULID().Time() {
+			t.Errorf("XULID(%d) sorts before an earlier timestamp", ms)
+		}
+	}
+}